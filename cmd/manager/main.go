@@ -19,8 +19,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	//+kubebuilder:scaffold:imports
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	cdPipeApi "github.com/epam/edp-cd-pipeline-operator/v2/pkg/apis/edp/v1"
 	codebaseApi "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1"
@@ -34,15 +36,23 @@ import (
 	jenkinsdeployment "github.com/epam/edp-jenkins-operator/v2/pkg/controller/cdstagejenkinsdeployment"
 	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/helper"
 	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/jenkins"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/jenkins_agentpool"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/jenkins_apitoken"
 	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/jenkins_authorizationrole"
 	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/jenkins_authorizationrolemapping"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/jenkins_configurationascode"
 	jenkinsFolder "github.com/epam/edp-jenkins-operator/v2/pkg/controller/jenkins_folder"
 	jenkinsJob "github.com/epam/edp-jenkins-operator/v2/pkg/controller/jenkins_job"
 	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/jenkins_jobbuildrun"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/jenkins_multibranchpipeline"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/jenkins_user"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/jenkins_view"
 	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/jenkinsagent"
 	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/jenkinsscript"
 	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/jenkinsserviceaccount"
 	sharedLibrary "github.com/epam/edp-jenkins-operator/v2/pkg/controller/shared_library"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/service/authorization"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/service/buildevents"
 	jenkinsService "github.com/epam/edp-jenkins-operator/v2/pkg/service/jenkins"
 	"github.com/epam/edp-jenkins-operator/v2/pkg/service/platform"
 	platformHelper "github.com/epam/edp-jenkins-operator/v2/pkg/service/platform/helper"
@@ -64,10 +74,16 @@ func main() {
 		metricsAddr          string
 		enableLeaderElection bool
 		probeAddr            string
+		buildEventsAddr      string
+		enableWebhooks       bool
 	)
 
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
+	flag.StringVar(&buildEventsAddr, "build-events-bind-address", "",
+		"The address the Jenkins build events endpoint binds to. Build events are disabled if empty.")
+	flag.BoolVar(&enableWebhooks, "enable-webhooks", false,
+		"Enable admission webhooks validating custom resources. Requires serving certificates to be mounted.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", clusterUtil.RunningInCluster(),
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
@@ -197,8 +213,22 @@ func main() {
 		os.Exit(1)
 	}
 
+	var buildEvents <-chan event.GenericEvent
+
+	if buildEventsAddr != "" {
+		// the server passes the events to the controller in memory, so it runs on the leader only
+		// and the operator must be deployed as a single replica.
+		buildEventsServer := buildevents.NewServer(buildEventsAddr, cl, ctrlLog)
+		if err := mgr.Add(buildEventsServer); err != nil {
+			setupLog.Error(err, "unable to add build events server")
+			os.Exit(1)
+		}
+
+		buildEvents = buildEventsServer.Events()
+	}
+
 	jjbr := jenkins_jobbuildrun.NewReconciler(cl, ctrlLog, ps)
-	if err := jjbr.SetupWithManager(mgr); err != nil {
+	if err := jjbr.SetupWithManager(mgr, buildEvents); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "jenkins-job-build-run")
 		os.Exit(1)
 	}
@@ -213,11 +243,47 @@ func main() {
 		os.Exit(1)
 	}
 
-	if err := jenkinsagent.NewReconciler(cl, ctrlLog).SetupWithManager(mgr); err != nil {
+	if err := jenkins_user.NewReconciler(cl, ctrlLog, ps).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "jenkins-user")
+		os.Exit(1)
+	}
+
+	if err := jenkins_apitoken.NewReconciler(cl, ctrlLog, ps).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "jenkins-api-token")
+		os.Exit(1)
+	}
+
+	if err := jenkins_configurationascode.NewReconciler(cl, ctrlLog, ps).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "jenkins-configuration-as-code")
+		os.Exit(1)
+	}
+
+	if enableWebhooks {
+		mgr.GetWebhookServer().Register(authorization.RoleValidationPath, &webhook.Admission{
+			Handler: authorization.NewRoleValidator(cl, ps, ctrlLog),
+		})
+	}
+
+	if err := jenkinsagent.NewReconciler(cl, ctrlLog, ps).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "jenkins-agent")
 		os.Exit(1)
 	}
 
+	if err := jenkins_agentpool.NewReconciler(cl, ctrlLog, ps).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "jenkins-agent-pool")
+		os.Exit(1)
+	}
+
+	if err := jenkins_view.NewReconciler(cl, ctrlLog, ps).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "jenkins-view")
+		os.Exit(1)
+	}
+
+	if err := jenkins_multibranchpipeline.NewReconciler(cl, ctrlLog, ps).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "jenkins-multibranch-pipeline")
+		os.Exit(1)
+	}
+
 	templatePath := defaultEnv(
 		"TEMPLATES_PATH", path.Join(platformHelper.DefaultConfigsAbsolutePath, jenkinsService.DefaultTemplatesDirectory))
 
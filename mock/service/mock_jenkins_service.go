@@ -138,6 +138,96 @@ func (_m *JenkinsService) IsDeploymentReady(instance *v1.Jenkins) (bool, error)
 	return r0, r1
 }
 
+// RotateAdminCredentials provides a mock function with given fields: instance
+func (_m *JenkinsService) RotateAdminCredentials(instance *v1.Jenkins) (*v1.Jenkins, bool, error) {
+	ret := _m.Called(instance)
+
+	var r0 *v1.Jenkins
+	if rf, ok := ret.Get(0).(func(*v1.Jenkins) *v1.Jenkins); ok {
+		r0 = rf(instance)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.Jenkins)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func(*v1.Jenkins) bool); ok {
+		r1 = rf(instance)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(*v1.Jenkins) error); ok {
+		r2 = rf(instance)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// SyncKubernetesClouds provides a mock function with given fields: instance
+func (_m *JenkinsService) SyncKubernetesClouds(instance *v1.Jenkins) (*v1.Jenkins, bool, error) {
+	ret := _m.Called(instance)
+
+	var r0 *v1.Jenkins
+	if rf, ok := ret.Get(0).(func(*v1.Jenkins) *v1.Jenkins); ok {
+		r0 = rf(instance)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.Jenkins)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func(*v1.Jenkins) bool); ok {
+		r1 = rf(instance)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(*v1.Jenkins) error); ok {
+		r2 = rf(instance)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// SyncPlugins provides a mock function with given fields: instance
+func (_m *JenkinsService) SyncPlugins(instance *v1.Jenkins) (*v1.Jenkins, bool, error) {
+	ret := _m.Called(instance)
+
+	var r0 *v1.Jenkins
+	if rf, ok := ret.Get(0).(func(*v1.Jenkins) *v1.Jenkins); ok {
+		r0 = rf(instance)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.Jenkins)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func(*v1.Jenkins) bool); ok {
+		r1 = rf(instance)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(*v1.Jenkins) error); ok {
+		r2 = rf(instance)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 type mockConstructorTestingTNewJenkinsService interface {
 	mock.TestingT
 	Cleanup(func())
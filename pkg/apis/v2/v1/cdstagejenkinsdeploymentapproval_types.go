@@ -0,0 +1,39 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CDStageJenkinsDeploymentApprovalSpec defines the desired state of CDStageJenkinsDeploymentApproval.
+type CDStageJenkinsDeploymentApprovalSpec struct {
+	// Deployment is a name of the approved CDStageJenkinsDeployment in the same namespace.
+	Deployment string `json:"deployment"`
+
+	// ApprovedBy is a name of the approver.
+	ApprovedBy string `json:"approvedBy"`
+
+	// +optional
+	Comment string `json:"comment,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:storageversion
+
+// CDStageJenkinsDeploymentApproval is the Schema for the cdstagejenkinsdeploymentapprovals API.
+type CDStageJenkinsDeploymentApproval struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec CDStageJenkinsDeploymentApprovalSpec `json:"spec"`
+}
+
+//+kubebuilder:object:root=true
+
+// CDStageJenkinsDeploymentApprovalList contains a list of CDStageJenkinsDeploymentApproval.
+type CDStageJenkinsDeploymentApprovalList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CDStageJenkinsDeploymentApproval `json:"items"`
+}
@@ -1,11 +1,55 @@
 package v1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 const (
 	failed = "failed"
+
+	// CDStageJenkinsDeploymentStatusRunning means that the deploy job has been triggered and is not finished yet.
+	CDStageJenkinsDeploymentStatusRunning = "running"
+	// CDStageJenkinsDeploymentStatusSucceeded means that the deploy build has finished successfully.
+	CDStageJenkinsDeploymentStatusSucceeded = "succeeded"
+	// CDStageJenkinsDeploymentStatusFailed means that the deploy build has failed or an error occurred.
+	CDStageJenkinsDeploymentStatusFailed = failed
+	// CDStageJenkinsDeploymentStatusQueued means that the deployment waits for other deployments of the same stage.
+	CDStageJenkinsDeploymentStatusQueued = "queued"
+	// CDStageJenkinsDeploymentStatusSuperseded means that the deployment has been replaced by a newer one before start.
+	CDStageJenkinsDeploymentStatusSuperseded = "superseded"
+
+	// ConcurrencyPolicyQueue makes a new deployment wait until all previous deployments of the stage are finished.
+	ConcurrencyPolicyQueue = "Queue"
+	// ConcurrencyPolicySupersede makes a new deployment replace previous deployments of the stage which are not started yet.
+	ConcurrencyPolicySupersede = "Supersede"
+
+	// RollbackPolicyNever disables automatic rollback.
+	RollbackPolicyNever = "Never"
+	// RollbackPolicyOnFailure redeploys the last successfully deployed tags of the stage if the deploy build fails.
+	RollbackPolicyOnFailure = "OnFailure"
+
+	// RollbackStatusSkipped means that there is no known-good tag set to roll back to.
+	RollbackStatusSkipped = "skipped"
+
+	// DeployJobStatusPending means that the job has not been triggered yet.
+	DeployJobStatusPending = "pending"
+
+	// JobsExecutionSequence runs the next job only after the previous one has succeeded.
+	JobsExecutionSequence = "Sequence"
+	// JobsExecutionParallel runs all jobs at once.
+	JobsExecutionParallel = "Parallel"
+
+	// CDStageJenkinsDeploymentStatusPending means that the deployment waits for a deployment window or an approval.
+	CDStageJenkinsDeploymentStatusPending = "pending"
+
+	// PendingReasonAwaitingApproval means that the deployment requires an approval which has not been given yet.
+	PendingReasonAwaitingApproval = "AwaitingApproval"
+	// PendingReasonOutsideWindow means that the deployment is allowed only within deployment windows.
+	PendingReasonOutsideWindow = "OutsideDeploymentWindow"
+
+	// ApprovedByAnnotation approves the deployment. Its value is the approver name.
+	ApprovedByAnnotation = "edp.epam.com/approved-by"
 )
 
 // CDStageJenkinsDeploymentSpec defines the desired state of CDStageJenkinsDeployment.
@@ -17,6 +61,95 @@ type CDStageJenkinsDeploymentSpec struct {
 	// +nullable
 	// +optional
 	Tags []Tag `json:"tags,omitempty"`
+
+	// ConcurrencyPolicy defines how the deployment is handled while another deployment of the same stage is in progress.
+	// Queue waits for the previous deployments, Supersede replaces the previous deployments which are not started yet.
+	// +kubebuilder:validation:Enum=Queue;Supersede
+	// +optional
+	ConcurrencyPolicy string `json:"concurrencyPolicy,omitempty"`
+
+	// RollbackPolicy defines what to do if the deploy build fails.
+	// Never does nothing, OnFailure triggers the deploy job with the last successfully deployed tags of the stage.
+	// +kubebuilder:validation:Enum=Never;OnFailure
+	// +optional
+	RollbackPolicy string `json:"rollbackPolicy,omitempty"`
+
+	// Params are additional parameters passed to every triggered job.
+	// +nullable
+	// +optional
+	Params []DeployJobParam `json:"params,omitempty"`
+
+	// Jobs is a list of jobs to run for the deployment, e.g. deploy and then smoke tests.
+	// If it is set, it is used instead of Job. The first job is the deploy job of the stage.
+	// +nullable
+	// +optional
+	Jobs []DeployJob `json:"jobs,omitempty"`
+
+	// JobsExecution defines how Jobs are run: Sequence runs the next job after the previous one has succeeded,
+	// Parallel runs all jobs at once.
+	// +kubebuilder:validation:Enum=Sequence;Parallel
+	// +optional
+	JobsExecution string `json:"jobsExecution,omitempty"`
+
+	// Windows restrict the start of the deployment to the given time windows.
+	// The deployment can start if any window is open. If empty, the deployment can start at any time.
+	// +nullable
+	// +optional
+	Windows []DeploymentWindow `json:"windows,omitempty"`
+
+	// ApprovalRequired makes the deployment wait for an approval. The deployment is approved by
+	// the edp.epam.com/approved-by annotation or by a CDStageJenkinsDeploymentApproval which refers to it.
+	// +optional
+	ApprovalRequired bool `json:"approvalRequired,omitempty"`
+}
+
+// DeploymentWindow is a time window in which the deployment can start.
+type DeploymentWindow struct {
+	// Schedule is a cron expression with five fields which defines when the window opens, e.g. "0 22 * * 1-5".
+	Schedule string `json:"schedule"`
+
+	// Duration is how long the window stays open, e.g. "2h" or "30m".
+	Duration string `json:"duration"`
+
+	// TimeZone is an IANA time zone of the schedule, e.g. "Europe/Kyiv". UTC is used by default.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// DeployJob is a Jenkins job run for the deployment.
+type DeployJob struct {
+	// Name is a full path of the Jenkins job.
+	Name string `json:"name"`
+
+	// Params are parameters passed to this job only. They override Params of the deployment.
+	// +nullable
+	// +optional
+	Params []DeployJobParam `json:"params,omitempty"`
+}
+
+// DeployJobParam is a Jenkins job parameter.
+type DeployJobParam struct {
+	// Name is a name of the parameter.
+	Name string `json:"name"`
+
+	// Value is a literal value of the parameter.
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// ValueFrom is a source of the parameter value. It is used instead of Value if set.
+	// +optional
+	ValueFrom *DeployJobParamSource `json:"valueFrom,omitempty"`
+}
+
+// DeployJobParamSource is a source of the Jenkins job parameter value.
+type DeployJobParamSource struct {
+	// SecretKeyRef selects a key of a Secret in the deployment namespace.
+	// +optional
+	SecretKeyRef *corev1.SecretKeySelector `json:"secretKeyRef,omitempty"`
+
+	// ConfigMapKeyRef selects a key of a ConfigMap in the deployment namespace.
+	// +optional
+	ConfigMapKeyRef *corev1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
 }
 
 type Tag struct {
@@ -32,6 +165,93 @@ type CDStageJenkinsDeploymentStatus struct {
 	Message string `json:"message,omitempty"`
 	// +optional
 	FailureCount int64 `json:"failureCount,omitempty"`
+
+	// Reason is a reason why the deployment is pending: AwaitingApproval or OutsideDeploymentWindow.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// BuildResult is a result of the finished deployment: SUCCESS if all jobs have succeeded,
+	// otherwise the result of the failed job.
+	// +optional
+	BuildResult string `json:"buildResult,omitempty"`
+
+	// Jobs is a state of each job of the deployment.
+	// +nullable
+	// +optional
+	Jobs []DeployJobStatus `json:"jobs,omitempty"`
+
+	// QueuePosition is a number of deployments of the same stage which are ahead of this one.
+	// +optional
+	QueuePosition int `json:"queuePosition,omitempty"`
+
+	// Rollback is a state of the automatic rollback performed after the failed deploy build.
+	// +optional
+	Rollback *CDStageJenkinsDeploymentRollback `json:"rollback,omitempty"`
+
+	// CleanupDone is true when the steps following the finished deployment have been performed
+	// and the CDStageDeploy has been deleted.
+	// +optional
+	CleanupDone bool `json:"cleanupDone,omitempty"`
+}
+
+// CDStageJenkinsDeploymentRollback defines the observed state of the automatic rollback.
+type CDStageJenkinsDeploymentRollback struct {
+	// Status is a rollback status: running, succeeded, failed or skipped.
+	Status string `json:"status"`
+
+	// Tags is the last known-good tag set which is redeployed.
+	// +nullable
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	DeployBuild `json:",inline"`
+}
+
+// DeployJobStatus defines the observed state of a job of the deployment.
+type DeployJobStatus struct {
+	// Name is a full path of the Jenkins job.
+	Name string `json:"name"`
+
+	// Status is a job status: pending, running, succeeded or failed.
+	Status string `json:"status"`
+
+	// Message is a reason of the failure when no build has been started for the job.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	DeployBuild `json:",inline"`
+}
+
+// DeployBuild is a state of the triggered Jenkins build.
+type DeployBuild struct {
+	// QueueItem is an ID of the Jenkins queue item created by the job trigger.
+	// +optional
+	QueueItem int64 `json:"queueItem,omitempty"`
+
+	// BuildNumber is a number of the build.
+	// +optional
+	BuildNumber int64 `json:"buildNumber,omitempty"`
+
+	// BuildURL is a URL of the build.
+	// +optional
+	BuildURL string `json:"buildUrl,omitempty"`
+
+	// BuildResult is a result of the finished build, e.g. SUCCESS or FAILURE.
+	// +optional
+	BuildResult string `json:"buildResult,omitempty"`
+}
+
+// IsTriggered returns true if the build has been put into the Jenkins queue.
+func (in *DeployBuild) IsTriggered() bool {
+	return in.QueueItem != 0
+}
+
+// IsFinished returns true if the build has finished.
+func (in *DeployBuild) IsFinished() bool {
+	return in.BuildResult != ""
 }
 
 //+kubebuilder:object:root=true
@@ -39,6 +259,7 @@ type CDStageJenkinsDeploymentStatus struct {
 //+kubebuilder:storageversion
 
 // CDStageJenkinsDeployment is the Schema for the cdstagejenkinsdeployments API.
+// The deployment is kept after its CDStageDeploy has been deleted to report the result.
 type CDStageJenkinsDeployment struct {
 	metav1.TypeMeta `json:",inline"`
 	// +optional
@@ -55,6 +276,69 @@ func (in *CDStageJenkinsDeployment) SetFailedStatus(err error) {
 	in.Status.Message = err.Error()
 }
 
+// IsCompleted returns true if the deployment does not require any further processing.
+// The finished deployment is completed only after its CDStageDeploy has been deleted.
+func (in *CDStageJenkinsDeployment) IsCompleted() bool {
+	return in.Status.Status == CDStageJenkinsDeploymentStatusSuperseded || in.Status.CleanupDone
+}
+
+// IsRollbackInProgress returns true if the rollback has been started and is not finished yet.
+func (in *CDStageJenkinsDeployment) IsRollbackInProgress() bool {
+	return in.Status.Rollback != nil && in.Status.Rollback.Status == CDStageJenkinsDeploymentStatusRunning
+}
+
+// IsStarted returns true if any job of the deployment has been triggered.
+func (in *CDStageJenkinsDeployment) IsStarted() bool {
+	for i := range in.Status.Jobs {
+		if in.Status.Jobs[i].IsTriggered() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetJobs returns the jobs of the deployment. Job is used if Jobs are not set.
+func (in *CDStageJenkinsDeployment) GetJobs() []DeployJob {
+	if len(in.Spec.Jobs) > 0 {
+		return in.Spec.Jobs
+	}
+
+	return []DeployJob{{Name: in.Spec.Job}}
+}
+
+// GetStageJob returns the deploy job of the stage which identifies the stage.
+func (in *CDStageJenkinsDeployment) GetStageJob() DeployJob {
+	return in.GetJobs()[0]
+}
+
+// GetJobsExecution returns the jobs execution mode, Sequence is used by default.
+func (in *CDStageJenkinsDeployment) GetJobsExecution() string {
+	if in.Spec.JobsExecution == "" {
+		return JobsExecutionSequence
+	}
+
+	return in.Spec.JobsExecution
+}
+
+// GetConcurrencyPolicy returns the concurrency policy, Queue is used by default.
+func (in *CDStageJenkinsDeployment) GetConcurrencyPolicy() string {
+	if in.Spec.ConcurrencyPolicy == "" {
+		return ConcurrencyPolicyQueue
+	}
+
+	return in.Spec.ConcurrencyPolicy
+}
+
+// GetRollbackPolicy returns the rollback policy, Never is used by default.
+func (in *CDStageJenkinsDeployment) GetRollbackPolicy() string {
+	if in.Spec.RollbackPolicy == "" {
+		return RollbackPolicyNever
+	}
+
+	return in.Spec.RollbackPolicy
+}
+
 //+kubebuilder:object:root=true
 
 // CDStageJenkinsDeploymentList contains a list of CDStageJenkinsDeployment.
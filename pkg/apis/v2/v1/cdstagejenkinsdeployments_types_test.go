@@ -14,3 +14,32 @@ func TestCDStageJenkinsDeployment_SetFailedStatus(t *testing.T) {
 	assert.Equal(t, errTest.Error(), instance.Status.Message)
 	assert.Equal(t, failed, instance.Status.Status)
 }
+
+func TestCDStageJenkinsDeployment_IsCompleted(t *testing.T) {
+	instance := CDStageJenkinsDeployment{}
+	assert.False(t, instance.IsCompleted())
+	assert.Equal(t, ConcurrencyPolicyQueue, instance.GetConcurrencyPolicy())
+
+	instance.Status.Status = CDStageJenkinsDeploymentStatusSuperseded
+	assert.True(t, instance.IsCompleted())
+
+	instance.Status.Status = CDStageJenkinsDeploymentStatusFailed
+	instance.Status.BuildResult = "FAILURE"
+	assert.False(t, instance.IsCompleted())
+
+	instance.Status.CleanupDone = true
+	assert.True(t, instance.IsCompleted())
+}
+
+func TestCDStageJenkinsDeployment_IsRollbackInProgress(t *testing.T) {
+	instance := CDStageJenkinsDeployment{}
+	assert.Equal(t, RollbackPolicyNever, instance.GetRollbackPolicy())
+	assert.False(t, instance.IsRollbackInProgress())
+
+	instance.Status.BuildResult = "FAILURE"
+	instance.Status.Rollback = &CDStageJenkinsDeploymentRollback{Status: CDStageJenkinsDeploymentStatusRunning}
+	assert.True(t, instance.IsRollbackInProgress())
+
+	instance.Status.Rollback.Status = CDStageJenkinsDeploymentStatusSucceeded
+	assert.False(t, instance.IsRollbackInProgress())
+}
@@ -1,9 +1,27 @@
 package v1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const (
+	// AuthorizationStrategyRoleStrategy manages roles with the Role Strategy plugin.
+	AuthorizationStrategyRoleStrategy = "roleStrategy"
+	// AuthorizationStrategyMatrix manages roles on top of the Matrix Authorization Strategy plugin.
+	AuthorizationStrategyMatrix = "matrix"
+
+	// PluginsRestartPolicySafe restarts Jenkins once running builds are finished.
+	PluginsRestartPolicySafe = "safe"
+	// PluginsRestartPolicyNever leaves the restart to the administrator.
+	PluginsRestartPolicyNever = "never"
+
+	// PluginStateInstalled means the desired plugin version is installed.
+	PluginStateInstalled = "installed"
+	// PluginStatePending means the plugin is being installed or uninstalled, or waits for a Jenkins restart.
+	PluginStatePending = "pending"
+)
+
 // JenkinsSpec defines the desired state of Jenkins.
 type JenkinsSpec struct {
 	// RestAPIUrl jenkins full rest api url
@@ -18,6 +36,180 @@ type JenkinsSpec struct {
 	KeycloakSpec    KeycloakSpec             `json:"keycloakSpec"`
 	// +optional
 	EdpSpec EdpSpec `json:"edpSpec,omitempty"`
+	// AuthorizationStrategy is the authorization plugin used by JenkinsAuthorizationRole and
+	// JenkinsAuthorizationRoleMapping. The Role Strategy plugin is used by default.
+	// +kubebuilder:validation:Enum=roleStrategy;matrix
+	// +optional
+	AuthorizationStrategy string `json:"authorizationStrategy,omitempty"`
+	// SecurityRealm configures a generic OIDC or LDAP identity provider.
+	// It can not be used together with keycloakSpec.
+	// +optional
+	SecurityRealm *SecurityRealm `json:"securityRealm,omitempty"`
+	// AdminCredentialsRotation enables periodic rotation of the admin password and API token.
+	// +optional
+	AdminCredentialsRotation *AdminCredentialsRotation `json:"adminCredentialsRotation,omitempty"`
+	// PluginManagement installs, pins and uninstalls plugins through the update center.
+	// +optional
+	PluginManagement *PluginManagement `json:"pluginManagement,omitempty"`
+	// KubernetesClouds configure the Kubernetes plugin clouds, the operator corrects their drift.
+	// The default "openshift" cloud, which holds the agent templates, is configured with the defaults unless
+	// a cloud with this name is listed. A cloud removed from the list is deleted from Jenkins except the default one,
+	// which keeps its last settings.
+	// +nullable
+	// +optional
+	KubernetesClouds []KubernetesCloud `json:"kubernetesClouds,omitempty"`
+}
+
+// KubernetesCloud defines a Kubernetes plugin cloud which runs the agent pods.
+type KubernetesCloud struct {
+	// Name is the cloud name, it is referred to by the pod templates.
+	Name string `json:"name"`
+	// ServerURL is the Kubernetes API server URL, the cluster Jenkins runs in is used if empty.
+	// +optional
+	ServerURL string `json:"serverURL,omitempty"`
+	// Namespace the agent pods are created in, the Jenkins namespace by default.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// CredentialsID is the id of the Jenkins credentials used to connect to the Kubernetes API server,
+	// the Jenkins service account is used if empty.
+	// +optional
+	CredentialsID string `json:"credentialsID,omitempty"`
+	// +optional
+	SkipTLSVerify bool `json:"skipTLSVerify,omitempty"`
+	// JenkinsURL is the URL the agents connect to, the Jenkins service URL by default.
+	// +optional
+	JenkinsURL string `json:"jenkinsURL,omitempty"`
+	// JenkinsTunnel is the host:port of the inbound agent TCP port, it is not used if webSocket is set.
+	// +optional
+	JenkinsTunnel string `json:"jenkinsTunnel,omitempty"`
+	// WebSocket makes the agents connect over WebSocket instead of the inbound TCP port.
+	// +optional
+	WebSocket bool `json:"webSocket,omitempty"`
+	// ContainerCap is the maximum number of agent pods, 50 by default.
+	// +optional
+	ContainerCap *int32 `json:"containerCap,omitempty"`
+	// PodRetention defines when the agent pods are kept after the build, "Never" by default.
+	// +kubebuilder:validation:Enum=Never;Always;OnFailure
+	// +optional
+	PodRetention string `json:"podRetention,omitempty"`
+	// ConnectTimeout is the Kubernetes API connection timeout in seconds, 5 by default.
+	// +optional
+	ConnectTimeout *int32 `json:"connectTimeout,omitempty"`
+	// ReadTimeout is the Kubernetes API read timeout in seconds, 15 by default.
+	// +optional
+	ReadTimeout *int32 `json:"readTimeout,omitempty"`
+}
+
+// PluginManagement defines the plugins managed by the operator.
+// Plugins which are not listed and were never listed are left untouched.
+type PluginManagement struct {
+	// Plugins are installed if missing. A plugin removed from the list is uninstalled.
+	// +optional
+	Plugins []JenkinsPlugin `json:"plugins,omitempty"`
+	// RestartPolicy defines how Jenkins is restarted to complete plugin changes, "safe" by default.
+	// +kubebuilder:validation:Enum=safe;never
+	// +optional
+	RestartPolicy string `json:"restartPolicy,omitempty"`
+	// DownloadURL is the base URL the pinned plugin versions are downloaded from,
+	// "https://updates.jenkins.io/download/plugins" by default.
+	// +optional
+	DownloadURL string `json:"downloadURL,omitempty"`
+}
+
+type JenkinsPlugin struct {
+	// Name is the plugin short name, e.g. "git".
+	Name string `json:"name"`
+	// Version pins the plugin version. The latest version is installed if empty and the installed one is never upgraded.
+	// +optional
+	Version string `json:"version,omitempty"`
+}
+
+// AdminCredentialsRotation defines when the admin password and API token are replaced.
+type AdminCredentialsRotation struct {
+	// Schedule is a cron expression with five fields which defines when the credentials are rotated, e.g. "0 3 * * 0".
+	Schedule string `json:"schedule"`
+	// TokenGracePeriod is how long the replaced admin token stays valid, e.g. "30m". One hour by default.
+	// +optional
+	TokenGracePeriod string `json:"tokenGracePeriod,omitempty"`
+}
+
+// SecurityRealm defines the identity provider used by Jenkins to authenticate users.
+// Exactly one of oidc and ldap must be set.
+type SecurityRealm struct {
+	// OIDC configures authentication with a generic OpenID Connect provider (oic-auth plugin).
+	// +optional
+	OIDC *OIDCSecurityRealm `json:"oidc,omitempty"`
+	// LDAP configures authentication with an LDAP server (ldap plugin).
+	// +optional
+	LDAP *LDAPSecurityRealm `json:"ldap,omitempty"`
+}
+
+// OIDCSecurityRealm defines a generic OpenID Connect provider.
+type OIDCSecurityRealm struct {
+	// IssuerURL is the OpenID provider issuer, its configuration is discovered from <issuerURL>/.well-known/openid-configuration.
+	IssuerURL string `json:"issuerURL"`
+	// ClientID is the OpenID client id of Jenkins.
+	ClientID string `json:"clientID"`
+	// ClientSecretRef selects the key of a Secret in the Jenkins namespace which holds the client secret.
+	// Jenkins reads it with its service account when the realm is configured.
+	ClientSecretRef corev1.SecretKeySelector `json:"clientSecretRef"`
+	// Scopes requested from the provider, "openid", "email" and "profile" by default.
+	// +optional
+	Scopes []string `json:"scopes,omitempty"`
+	// +optional
+	ClaimMappings OIDCClaimMappings `json:"claimMappings,omitempty"`
+	// +optional
+	DisableSSLVerification bool `json:"disableSSLVerification,omitempty"`
+}
+
+// OIDCClaimMappings defines the token claims which hold the user attributes.
+type OIDCClaimMappings struct {
+	// UserName claim, "preferred_username" by default.
+	// +optional
+	UserName string `json:"userName,omitempty"`
+	// FullName claim, "name" by default.
+	// +optional
+	FullName string `json:"fullName,omitempty"`
+	// Email claim, "email" by default.
+	// +optional
+	Email string `json:"email,omitempty"`
+	// Groups claim, "groups" by default.
+	// +optional
+	Groups string `json:"groups,omitempty"`
+}
+
+// LDAPSecurityRealm defines an LDAP server and its user and group searches.
+type LDAPSecurityRealm struct {
+	// Server is the LDAP server URL, e.g. ldaps://ldap.example.com:636.
+	Server string `json:"server"`
+	// RootDN is the root DN of the searches, it is inferred from the server if empty.
+	// +optional
+	RootDN string `json:"rootDN,omitempty"`
+	// BindDN is the DN used to bind to the server, anonymous bind is used if empty.
+	// +optional
+	BindDN string `json:"bindDN,omitempty"`
+	// BindPasswordRef selects the key of a Secret in the Jenkins namespace which holds the bind password.
+	// Jenkins reads it with its service account when the realm is configured.
+	// +optional
+	BindPasswordRef *corev1.SecretKeySelector `json:"bindPasswordRef,omitempty"`
+	// UserSearchBase is the base DN of the user search relative to the root DN.
+	// +optional
+	UserSearchBase string `json:"userSearchBase,omitempty"`
+	// UserSearchFilter is the user search filter, "uid={0}" by default.
+	// +optional
+	UserSearchFilter string `json:"userSearchFilter,omitempty"`
+	// GroupSearchBase is the base DN of the group search relative to the root DN.
+	// +optional
+	GroupSearchBase string `json:"groupSearchBase,omitempty"`
+	// GroupSearchFilter is the group search filter.
+	// +optional
+	GroupSearchFilter string `json:"groupSearchFilter,omitempty"`
+	// DisplayNameAttribute is the user display name attribute, "displayname" by default.
+	// +optional
+	DisplayNameAttribute string `json:"displayNameAttribute,omitempty"`
+	// MailAttribute is the user email attribute, "mail" by default.
+	// +optional
+	MailAttribute string `json:"mailAttribute,omitempty"`
 }
 
 type EdpSpec struct {
@@ -52,6 +244,61 @@ type JenkinsStatus struct {
 	// +nullable
 	// +optional
 	JobProvisions []JobProvision `json:"jobProvisions,omitempty"`
+	// +nullable
+	// +optional
+	AdminCredentials *AdminCredentialsStatus `json:"adminCredentials,omitempty"`
+	// +nullable
+	// +optional
+	Plugins *PluginsStatus `json:"plugins,omitempty"`
+	// KubernetesClouds are the names of the clouds configured from spec.kubernetesClouds.
+	// +optional
+	KubernetesClouds []string `json:"kubernetesClouds,omitempty"`
+}
+
+// PluginsStatus is the state of the managed plugins.
+type PluginsStatus struct {
+	// +optional
+	Plugins []PluginStatus `json:"plugins,omitempty"`
+	// RestartRequired is true when Jenkins has to be restarted to complete the plugin changes.
+	// +optional
+	RestartRequired bool `json:"restartRequired,omitempty"`
+	// +nullable
+	// +optional
+	LastRestartTime *metav1.Time `json:"lastRestartTime,omitempty"`
+	// Error is the error of the last plugins synchronization, the rest of the reconciliation is not blocked by it.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+type PluginStatus struct {
+	Name string `json:"name"`
+	// +optional
+	DesiredVersion string `json:"desiredVersion,omitempty"`
+	// +optional
+	InstalledVersion string `json:"installedVersion,omitempty"`
+	// +kubebuilder:validation:Enum=installed;pending
+	State string `json:"state"`
+}
+
+// AdminCredentialsStatus is the state of the admin credentials rotation.
+type AdminCredentialsStatus struct {
+	// +nullable
+	// +optional
+	LastRotationTime *metav1.Time `json:"lastRotationTime,omitempty"`
+	// +nullable
+	// +optional
+	NextRotationTime *metav1.Time `json:"nextRotationTime,omitempty"`
+	// TokenUUID is the id of the admin token stored in the admin token Secret.
+	// +optional
+	TokenUUID string `json:"tokenUUID,omitempty"`
+	// PendingRevocations are the replaced admin tokens which are revoked after the grace period.
+	// +optional
+	PendingRevocations []PendingTokenRevocation `json:"pendingRevocations,omitempty"`
+}
+
+type PendingTokenRevocation struct {
+	TokenUUID   string      `json:"tokenUUID"`
+	RevokeAfter metav1.Time `json:"revokeAfter"`
 }
 
 type Slave struct {
@@ -72,6 +319,27 @@ type KeycloakSpec struct {
 	IsPrivate bool `json:"isPrivate,omitempty"`
 	// +optional
 	SecretName string `json:"secretName,omitempty"`
+	// RoleMappings assign Jenkins roles to Keycloak groups and realm roles.
+	// The operator keeps a JenkinsAuthorizationRoleMapping for every entry and removes the ones which are no longer listed.
+	// The roles of the entries with the same subject and role type are merged.
+	// +optional
+	RoleMappings []KeycloakRoleMapping `json:"roleMappings,omitempty"`
+}
+
+// KeycloakRoleMapping assigns Jenkins roles to a Keycloak group or realm role.
+// Exactly one of group and realmRole must be set.
+type KeycloakRoleMapping struct {
+	// Group is the name of the Keycloak group.
+	// +optional
+	Group string `json:"group,omitempty"`
+	// RealmRole is the name of the Keycloak realm role.
+	// +optional
+	RealmRole string `json:"realmRole,omitempty"`
+	// RoleType is the type of the Jenkins roles.
+	// +kubebuilder:validation:Enum=globalRoles;projectRoles
+	RoleType string `json:"roleType"`
+	// Roles are the names of the Jenkins roles.
+	Roles []string `json:"roles"`
 }
 
 //+kubebuilder:object:root=true
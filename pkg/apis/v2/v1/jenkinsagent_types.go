@@ -3,12 +3,44 @@ package v1
 import (
 	"fmt"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type JenkinsAgentSpec struct {
-	Name     string `json:"name"`
-	Template string `json:"template"`
+	Name string `json:"name"`
+	// Template is the pod template in the Kubernetes plugin XML format.
+	// Exactly one of template and podTemplate must be set.
+	// +optional
+	Template string `json:"template,omitempty"`
+	// PodTemplate is the structured pod template the operator generates the Kubernetes plugin template from.
+	// +optional
+	PodTemplate *JenkinsAgentPodTemplate `json:"podTemplate,omitempty"`
+	// +nullable
+	// +optional
+	OwnerName *string `json:"ownerName,omitempty"`
+}
+
+// JenkinsAgentPodTemplate defines the agent pods started by the Kubernetes plugin.
+type JenkinsAgentPodTemplate struct {
+	// Label the builds select the agent with, the agent name by default.
+	// +optional
+	Label string `json:"label,omitempty"`
+	// IdleMinutes is how long an idle agent pod is kept for the next build, it is deleted right away by default.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	IdleMinutes int32 `json:"idleMinutes,omitempty"`
+	// InstanceCap is the maximum number of agent pods, it is unlimited by default.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	InstanceCap *int32 `json:"instanceCap,omitempty"`
+	// Template is merged with the pod generated by the Kubernetes plugin,
+	// a container named "jnlp" overrides the default agent container.
+	// The schema is omitted to keep the CRD small, the template is validated by the operator.
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Template corev1.PodTemplateSpec `json:"template"`
 }
 
 func (in JenkinsAgentSpec) SalvesKey() string {
@@ -17,6 +49,16 @@ func (in JenkinsAgentSpec) SalvesKey() string {
 
 type JenkinsAgentStatus struct {
 	Value string `json:"value"`
+	// Errors are the problems found in the pod template, the template is not applied until they are fixed.
+	// +optional
+	Errors []string `json:"errors,omitempty"`
+	// Registered is true when the template label is found in a Jenkins Kubernetes cloud.
+	// +optional
+	Registered bool `json:"registered,omitempty"`
+	// LastSeen is the last time the template label has been found in Jenkins.
+	// +nullable
+	// +optional
+	LastSeen *metav1.Time `json:"lastSeen,omitempty"`
 }
 
 // +kubebuilder:object:root=true
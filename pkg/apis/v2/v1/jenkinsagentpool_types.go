@@ -0,0 +1,94 @@
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// JenkinsAgentPoolSpec defines the permanent inbound agents run as a StatefulSet.
+type JenkinsAgentPoolSpec struct {
+	// Replicas is the number of agents. The nodes are named after the StatefulSet pods: <name>-<ordinal>.
+	// +kubebuilder:validation:Minimum=0
+	Replicas int32 `json:"replicas"`
+
+	// Labels of the nodes. The pool name is used if empty.
+	// +optional
+	Labels []string `json:"labels,omitempty"`
+
+	// Executors is the number of executors of each node, 1 by default.
+	// +kubebuilder:validation:Minimum=1
+	// +nullable
+	// +optional
+	Executors *int32 `json:"executors,omitempty"`
+
+	// Exclusive restricts the nodes to the builds which select their labels.
+	// +optional
+	Exclusive bool `json:"exclusive,omitempty"`
+
+	// RemoteFS is the agent working directory, /home/jenkins/agent by default.
+	// +optional
+	RemoteFS string `json:"remoteFS,omitempty"`
+
+	// WebSocket makes the agents connect through the Jenkins HTTP port instead of the agent port.
+	// +optional
+	WebSocket bool `json:"webSocket,omitempty"`
+
+	// Image of the jnlp container, jenkins/inbound-agent by default. It is ignored if the template has the jnlp container image.
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// JenkinsURL is the URL the agents connect to, the Jenkins service URL by default.
+	// +optional
+	JenkinsURL string `json:"jenkinsURL,omitempty"`
+
+	// Template of the agent pods. The connection settings and the secret are added to the jnlp container,
+	// it is created if the template has none.
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +nullable
+	// +optional
+	Template *corev1.PodTemplateSpec `json:"template,omitempty"`
+
+	// +nullable
+	// +optional
+	OwnerName *string `json:"ownerName,omitempty"`
+}
+
+// JenkinsAgentPoolStatus defines the observed state of JenkinsAgentPool.
+type JenkinsAgentPoolStatus struct {
+	Value string `json:"value"`
+
+	// Nodes are the names of the nodes created in Jenkins.
+	// +optional
+	Nodes []string `json:"nodes,omitempty"`
+
+	// ReadyReplicas is the number of ready agent pods.
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// JenkinsAgentPool is the pool of permanent inbound agents with nodes in Jenkins and pods in a StatefulSet.
+type JenkinsAgentPool struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +optional
+	Spec JenkinsAgentPoolSpec `json:"spec,omitempty"`
+	// +optional
+	Status JenkinsAgentPoolStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// JenkinsAgentPoolList contains a list of JenkinsAgentPool.
+type JenkinsAgentPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []JenkinsAgentPool `json:"items"`
+}
@@ -0,0 +1,90 @@
+package v1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// JenkinsAPITokenSpec defines an API token of a Jenkins user issued for an external consumer.
+type JenkinsAPITokenSpec struct {
+	// UserName is the id of the Jenkins user the token is issued for.
+	UserName string `json:"userName"`
+	// TokenName is the name of the token in Jenkins, the name of the resource is used by default.
+	// +optional
+	TokenName string `json:"tokenName,omitempty"`
+	// SecretName is the name of the Secret the token is stored in with the username and token keys.
+	SecretName string `json:"secretName"`
+	// RotationSchedule is a cron expression with five fields which defines when the token is replaced with a new one,
+	// e.g. "0 3 1 * *". The token is not rotated if it is empty.
+	// +optional
+	RotationSchedule string `json:"rotationSchedule,omitempty"`
+	// +nullable
+	// +optional
+	OwnerName *string `json:"ownerName,omitempty"`
+}
+
+// JenkinsAPITokenStatus defines the observed state of JenkinsAPIToken.
+type JenkinsAPITokenStatus struct {
+	Value string `json:"value"`
+
+	// TokenUUID is the id of the issued token in Jenkins.
+	// +optional
+	TokenUUID string `json:"tokenUUID,omitempty"`
+
+	// UserName is the Jenkins user the current token is issued for.
+	// +optional
+	UserName string `json:"userName,omitempty"`
+
+	// SecretName is the Secret the current token is stored in.
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+
+	// IssuedAt is the time the current token was issued.
+	// +nullable
+	// +optional
+	IssuedAt *metav1.Time `json:"issuedAt,omitempty"`
+
+	// NextRotationTime is the time the token is going to be rotated.
+	// +nullable
+	// +optional
+	NextRotationTime *metav1.Time `json:"nextRotationTime,omitempty"`
+
+	// PendingRevocation are the tokens which are no longer stored in the Secret and are still to be revoked.
+	// +optional
+	PendingRevocation []ReplacedAPIToken `json:"pendingRevocation,omitempty"`
+}
+
+// ReplacedAPIToken is a token issued in Jenkins which has been replaced or has not been stored.
+type ReplacedAPIToken struct {
+	// TokenUUID is the id of the token in Jenkins.
+	TokenUUID string `json:"tokenUUID"`
+
+	// UserName is the Jenkins user the token is issued for.
+	UserName string `json:"userName"`
+
+	// SecretName is the Secret the token was stored in, it is deleted with the token if it is no longer used.
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// JenkinsAPIToken is an API token of a Jenkins user managed by the operator.
+type JenkinsAPIToken struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +optional
+	Spec JenkinsAPITokenSpec `json:"spec,omitempty"`
+	// +optional
+	Status JenkinsAPITokenStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// JenkinsAPITokenList contains a list of JenkinsAPIToken.
+type JenkinsAPITokenList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []JenkinsAPIToken `json:"items"`
+}
@@ -14,6 +14,28 @@ type JenkinsAuthorizationRoleSpec struct {
 
 type JenkinsAuthorizationRoleStatus struct {
 	Value string `json:"value"`
+
+	// RoleName is the name of the role last applied in Jenkins.
+	// It is used to remove the old role when spec.name is changed.
+	// +optional
+	RoleName string `json:"roleName,omitempty"`
+
+	// RoleType is the type of the role last applied in Jenkins.
+	// +optional
+	RoleType string `json:"roleType,omitempty"`
+
+	// InvalidPermissions are the permissions from spec which are not known to Jenkins.
+	// +optional
+	InvalidPermissions []string `json:"invalidPermissions,omitempty"`
+
+	// PatternError is set when spec.pattern is not a valid regular expression.
+	// +optional
+	PatternError string `json:"patternError,omitempty"`
+
+	// LastSyncTime is the time of the last successful comparison of the role with Jenkins.
+	// +nullable
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -11,8 +11,43 @@ type JenkinsAuthorizationRoleMappingSpec struct {
 	Roles     []string `json:"roles"`
 }
 
+const (
+	RoleMappingActionAssign   = "assign"
+	RoleMappingActionUnassign = "unassign"
+)
+
 type JenkinsAuthorizationRoleMappingStatus struct {
 	Value string `json:"value"`
+
+	// Assigned is the set of role assignments made in Jenkins by the mapping.
+	// It is compared with the spec to unassign roles which are no longer desired.
+	// +optional
+	Assigned []RoleAssignment `json:"assigned,omitempty"`
+
+	// Roles holds per-role results of the last reconciliation.
+	// +optional
+	Roles []RoleAssignmentResult `json:"roles,omitempty"`
+}
+
+// RoleAssignment is a role assigned in Jenkins to the group.
+type RoleAssignment struct {
+	RoleType string `json:"roleType"`
+	Role     string `json:"role"`
+	Group    string `json:"group"`
+}
+
+// RoleAssignmentResult is the result of assigning or unassigning a single role.
+type RoleAssignmentResult struct {
+	RoleAssignment `json:",inline"`
+
+	// Action is either assign or unassign.
+	Action string `json:"action"`
+
+	// Status is success or error.
+	Status string `json:"status"`
+
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // +kubebuilder:object:root=true
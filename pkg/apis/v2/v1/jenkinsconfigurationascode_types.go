@@ -0,0 +1,66 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// JenkinsConfigurationAsCodeSpec defines the Configuration as Code (JCasC) YAML applied to Jenkins.
+type JenkinsConfigurationAsCodeSpec struct {
+	// Sources are the ConfigMaps with YAML fragments. The fragments are merged in the given order:
+	// mappings are merged, sequences are concatenated and conflicting values are rejected.
+	Sources []ConfigurationAsCodeSource `json:"sources"`
+	// +nullable
+	// +optional
+	OwnerName *string `json:"ownerName,omitempty"`
+}
+
+// ConfigurationAsCodeSource selects YAML fragments from a ConfigMap in the namespace.
+type ConfigurationAsCodeSource struct {
+	// ConfigMapName is the name of the ConfigMap.
+	ConfigMapName string `json:"configMapName"`
+	// Keys are the ConfigMap keys with the fragments. All keys are used in alphabetical order if empty.
+	// +optional
+	Keys []string `json:"keys,omitempty"`
+}
+
+// JenkinsConfigurationAsCodeStatus defines the observed state of JenkinsConfigurationAsCode.
+type JenkinsConfigurationAsCodeStatus struct {
+	Value string `json:"value"`
+
+	// Revision is the SHA-256 checksum of the last applied configuration.
+	// +optional
+	Revision string `json:"revision,omitempty"`
+
+	// +nullable
+	// +optional
+	LastAppliedTime *metav1.Time `json:"lastAppliedTime,omitempty"`
+
+	// Warnings are reported by the configuration validation of the last applied revision.
+	// +optional
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// JenkinsConfigurationAsCode is the Configuration as Code YAML assembled from ConfigMaps and applied to Jenkins.
+type JenkinsConfigurationAsCode struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +optional
+	Spec JenkinsConfigurationAsCodeSpec `json:"spec,omitempty"`
+	// +optional
+	Status JenkinsConfigurationAsCodeStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// JenkinsConfigurationAsCodeList contains a list of JenkinsConfigurationAsCode.
+type JenkinsConfigurationAsCodeList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []JenkinsConfigurationAsCode `json:"items"`
+}
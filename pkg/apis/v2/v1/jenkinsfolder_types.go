@@ -1,6 +1,8 @@
 package v1
 
 import (
+	"strings"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -15,6 +17,18 @@ type JenkinsFolderSpec struct {
 	// +nullable
 	// +optional
 	Job *Job `json:"job"`
+	// Permissions are granted to users and groups on the folder and all its items.
+	// The permissions of the entries with the same subject are merged.
+	// +optional
+	Permissions []FolderPermission `json:"permissions,omitempty"`
+}
+
+// FolderPermission grants permissions on the folder to the user or group.
+type FolderPermission struct {
+	// Subject is the name of the user or group.
+	Subject string `json:"subject"`
+	// Permissions are IDs of the granted permissions, e.g. hudson.model.Item.Build.
+	Permissions []string `json:"permissions"`
 }
 
 // JenkinsFolderStatus defines the observed state of JenkinsFolder.
@@ -25,6 +39,9 @@ type JenkinsFolderStatus struct {
 	LastTimeUpdated metav1.Time `json:"lastTimeUpdated,omitempty"`
 	// +optional
 	Status string `json:"status,omitempty"`
+	// PermissionRoles are the names of the project roles created in Jenkins for spec.permissions.
+	// +optional
+	PermissionRoles []string `json:"permissionRoles,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -52,3 +69,12 @@ type JenkinsFolderList struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []JenkinsFolder `json:"items"`
 }
+
+// GetFolderName returns the name of the folder in Jenkins.
+func (in *JenkinsFolder) GetFolderName() string {
+	if in.Spec.Job == nil {
+		return in.Name
+	}
+
+	return strings.ReplaceAll(in.Name, "-codebase", "")
+}
@@ -1,6 +1,7 @@
 package v1
 
 import (
+	"strconv"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -12,6 +13,13 @@ const (
 	JobBuildRunStatusFailed    = "failed"
 	JobBuildRunStatusRetrying  = "retrying"
 	JobBuildRunStatusNotFound  = "jobNotFound"
+	// JobBuildRunStatusWaiting means that the run waits for its upstream runs to be completed.
+	JobBuildRunStatusWaiting = "waiting"
+	// JobBuildRunStatusUpstreamFailed means that one of the upstream runs has not been completed successfully.
+	JobBuildRunStatusUpstreamFailed = "upstreamFailed"
+	// JobBuildRunStatusInvalidDependencies means that the run depends on itself, is a part of a dependency cycle
+	// or one of its upstream runs does not exist.
+	JobBuildRunStatusInvalidDependencies = "invalidDependencies"
 )
 
 type JenkinsJobBuildRunSpec struct {
@@ -26,6 +34,19 @@ type JenkinsJobBuildRunSpec struct {
 	// +nullable
 	// +optional
 	DeleteAfterCompletionInterval *string `json:"deleteAfterCompletionInterval,omitempty"`
+	// DependsOn is a list of upstream JenkinsJobBuildRuns from the same namespace
+	// which must be completed before this run is triggered.
+	// +nullable
+	// +optional
+	DependsOn []JenkinsJobBuildRunDependency `json:"dependsOn,omitempty"`
+}
+
+type JenkinsJobBuildRunDependency struct {
+	// Name is a name of the upstream JenkinsJobBuildRun.
+	Name string `json:"name"`
+	// BuildNumberParam is a name of the job parameter which receives the upstream build number.
+	// +optional
+	BuildNumberParam string `json:"buildNumberParam,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -55,11 +76,37 @@ func (in *JenkinsJobBuildRun) GetDeleteAfterCompletionInterval() time.Duration {
 	return dur
 }
 
+// GetBuildParams returns job parameters extended with the build numbers of the completed upstream runs.
+func (in *JenkinsJobBuildRun) GetBuildParams() map[string]string {
+	if len(in.Status.UpstreamBuilds) == 0 {
+		return in.Spec.Params
+	}
+
+	params := make(map[string]string, len(in.Spec.Params)+len(in.Spec.DependsOn))
+	for k, v := range in.Spec.Params {
+		params[k] = v
+	}
+
+	for _, dep := range in.Spec.DependsOn {
+		buildNumber, ok := in.Status.UpstreamBuilds[dep.Name]
+		if ok && dep.BuildNumberParam != "" {
+			params[dep.BuildNumberParam] = strconv.FormatInt(buildNumber, 10)
+		}
+	}
+
+	return params
+}
+
 type JenkinsJobBuildRunStatus struct {
 	Status      string      `json:"status"`
 	Launches    int         `json:"launches"`
 	BuildNumber int64       `json:"buildNumber"`
 	LastUpdated metav1.Time `json:"lastUpdated"`
+	// UpstreamBuilds contains build numbers of the completed upstream runs.
+	// +optional
+	UpstreamBuilds map[string]int64 `json:"upstreamBuilds,omitempty"`
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // +kubebuilder:object:root=true
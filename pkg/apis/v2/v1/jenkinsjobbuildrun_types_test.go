@@ -17,3 +17,26 @@ func TestJenkinsJobBuildRun_GetDeleteAfterCompletionInterval(t *testing.T) {
 	instance := JenkinsJobBuildRun{Spec: JenkinsJobBuildRunSpec{DeleteAfterCompletionInterval: &str}}
 	assert.Equal(t, 2*time.Hour, instance.GetDeleteAfterCompletionInterval())
 }
+
+func TestJenkinsJobBuildRun_GetBuildParams(t *testing.T) {
+	instance := JenkinsJobBuildRun{
+		Spec: JenkinsJobBuildRunSpec{
+			Params: map[string]string{"foo": "bar"},
+			DependsOn: []JenkinsJobBuildRunDependency{
+				{Name: "lib", BuildNumberParam: "LIB_BUILD"},
+				{Name: "e2e"},
+			},
+		},
+		Status: JenkinsJobBuildRunStatus{
+			UpstreamBuilds: map[string]int64{"lib": 12, "e2e": 3},
+		},
+	}
+
+	assert.Equal(t, map[string]string{"foo": "bar", "LIB_BUILD": "12"}, instance.GetBuildParams())
+	assert.Equal(t, map[string]string{"foo": "bar"}, instance.Spec.Params)
+}
+
+func TestJenkinsJobBuildRun_GetBuildParams_NoUpstream(t *testing.T) {
+	instance := JenkinsJobBuildRun{Spec: JenkinsJobBuildRunSpec{Params: map[string]string{"foo": "bar"}}}
+	assert.Equal(t, map[string]string{"foo": "bar"}, instance.GetBuildParams())
+}
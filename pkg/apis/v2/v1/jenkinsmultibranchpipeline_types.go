@@ -0,0 +1,190 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// MultibranchTypePipeline is the multibranch pipeline of a single repository.
+	MultibranchTypePipeline = "multibranch"
+	// MultibranchTypeOrganization is the organization folder with a multibranch pipeline for each repository of the owner.
+	MultibranchTypeOrganization = "organization"
+
+	BranchSourceGit    = "git"
+	BranchSourceGitHub = "github"
+
+	PullRequestStrategyMerge = "merge"
+	PullRequestStrategyHead  = "head"
+	PullRequestStrategyBoth  = "both"
+)
+
+// JenkinsMultibranchPipelineSpec defines the multibranch pipeline or the organization folder created in Jenkins.
+type JenkinsMultibranchPipelineSpec struct {
+	// Name of the job in Jenkins.
+	Name string `json:"name"`
+
+	// Type of the job, multibranch by default. The organization folder supports the github source only.
+	// +kubebuilder:validation:Enum=multibranch;organization
+	// +optional
+	Type string `json:"type,omitempty"`
+
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// FolderName is the name of the JenkinsFolder the job is created in. The job is created in the Jenkins root if empty.
+	// +nullable
+	// +optional
+	FolderName *string `json:"folderName,omitempty"`
+
+	Source BranchSource `json:"source"`
+
+	// ScriptPath is the path of the pipeline script in the repositories, Jenkinsfile by default.
+	// +optional
+	ScriptPath string `json:"scriptPath,omitempty"`
+
+	// +optional
+	Discovery BranchDiscovery `json:"discovery,omitempty"`
+
+	// +nullable
+	// +optional
+	OrphanedItemStrategy *OrphanedItemStrategy `json:"orphanedItemStrategy,omitempty"`
+
+	// ScanInterval is how often the repositories are scanned if no scan is triggered by a webhook,
+	// e.g. 30m or 4h, 24h by default.
+	// +optional
+	ScanInterval string `json:"scanInterval,omitempty"`
+
+	// ScanRequest triggers the scan when it is changed, e.g. it can be set to the current time.
+	// +optional
+	ScanRequest string `json:"scanRequest,omitempty"`
+
+	// +nullable
+	// +optional
+	OwnerName *string `json:"ownerName,omitempty"`
+}
+
+// BranchSource is the repository or the organization the branches are discovered in.
+type BranchSource struct {
+	// +kubebuilder:validation:Enum=git;github
+	Type string `json:"type"`
+
+	// Remote is the URL of the git repository.
+	// +optional
+	Remote string `json:"remote,omitempty"`
+
+	// Owner is the GitHub organization or user.
+	// +optional
+	Owner string `json:"owner,omitempty"`
+
+	// Repository is the GitHub repository, it is not used by the organization folder.
+	// +optional
+	Repository string `json:"repository,omitempty"`
+
+	// APIURL is the GitHub API URL, github.com is used if empty.
+	// +optional
+	APIURL string `json:"apiURL,omitempty"`
+
+	// ServiceAccountName is the name of the JenkinsServiceAccount with the repository credentials.
+	// +optional
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+}
+
+// BranchDiscovery defines the discovered branches, tags and pull requests.
+type BranchDiscovery struct {
+	// Branches enables the branch discovery, true by default.
+	// +nullable
+	// +optional
+	Branches *bool `json:"branches,omitempty"`
+
+	// +optional
+	Tags bool `json:"tags,omitempty"`
+
+	// PullRequests enables the discovery of the pull requests from the origin, github only.
+	// +kubebuilder:validation:Enum=merge;head;both
+	// +optional
+	PullRequests string `json:"pullRequests,omitempty"`
+
+	// ForkPullRequests enables the discovery of the pull requests from forks, github only.
+	// The pipeline script is trusted if the contributor has write permission.
+	// +kubebuilder:validation:Enum=merge;head;both
+	// +optional
+	ForkPullRequests string `json:"forkPullRequests,omitempty"`
+
+	// Includes are the space separated wildcards of the discovered names, * by default.
+	// +optional
+	Includes string `json:"includes,omitempty"`
+
+	// Excludes are the space separated wildcards of the ignored names.
+	// +optional
+	Excludes string `json:"excludes,omitempty"`
+}
+
+// OrphanedItemStrategy defines what happens to the jobs of the removed branches.
+type OrphanedItemStrategy struct {
+	// Prune removes the jobs of the removed branches according to DaysToKeep and NumToKeep, true by default.
+	// +nullable
+	// +optional
+	Prune *bool `json:"prune,omitempty"`
+
+	// DaysToKeep is how long the removed jobs are kept, they are removed immediately if not set.
+	// +kubebuilder:validation:Minimum=1
+	// +nullable
+	// +optional
+	DaysToKeep *int32 `json:"daysToKeep,omitempty"`
+
+	// NumToKeep is how many removed jobs are kept.
+	// +kubebuilder:validation:Minimum=1
+	// +nullable
+	// +optional
+	NumToKeep *int32 `json:"numToKeep,omitempty"`
+}
+
+// JenkinsMultibranchPipelineStatus defines the observed state of JenkinsMultibranchPipeline.
+type JenkinsMultibranchPipelineStatus struct {
+	Value string `json:"value"`
+
+	// JobName is the name of the job created in Jenkins.
+	// +optional
+	JobName string `json:"jobName,omitempty"`
+
+	// Folder is the full name of the Jenkins folder the job has been created in.
+	// +optional
+	Folder string `json:"folder,omitempty"`
+
+	// Type is the type of the job created in Jenkins, the job is recreated if the type is changed.
+	// +optional
+	Type string `json:"type,omitempty"`
+
+	// ScanRequest is the last handled scan request.
+	// +optional
+	ScanRequest string `json:"scanRequest,omitempty"`
+
+	// +nullable
+	// +optional
+	LastScanTime *metav1.Time `json:"lastScanTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// JenkinsMultibranchPipeline is the multibranch pipeline or the organization folder in Jenkins.
+type JenkinsMultibranchPipeline struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +optional
+	Spec JenkinsMultibranchPipelineSpec `json:"spec,omitempty"`
+	// +optional
+	Status JenkinsMultibranchPipelineStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// JenkinsMultibranchPipelineList contains a list of JenkinsMultibranchPipeline.
+type JenkinsMultibranchPipelineList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []JenkinsMultibranchPipeline `json:"items"`
+}
@@ -0,0 +1,74 @@
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// JenkinsUserSpec defines a user of the Jenkins own user database.
+type JenkinsUserSpec struct {
+	// UserName is the Jenkins user id.
+	UserName string `json:"userName"`
+	// PasswordRef selects the key of a Secret in the namespace which holds the user password.
+	PasswordRef corev1.SecretKeySelector `json:"passwordRef"`
+	// +optional
+	FullName string `json:"fullName,omitempty"`
+	// +optional
+	Email string `json:"email,omitempty"`
+	// APIToken requests an API token for the user, it is stored in a generated Secret.
+	// +optional
+	APIToken *JenkinsUserAPIToken `json:"apiToken,omitempty"`
+	// +nullable
+	// +optional
+	OwnerName *string `json:"ownerName,omitempty"`
+}
+
+// JenkinsUserAPIToken defines the API token issued for the user.
+type JenkinsUserAPIToken struct {
+	// SecretName is the name of the Secret with the username and token keys, "<name>-api-token" by default.
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+}
+
+// JenkinsUserStatus defines the observed state of JenkinsUser.
+type JenkinsUserStatus struct {
+	Value string `json:"value"`
+
+	// UserName is the Jenkins user id last applied.
+	// It is used to delete the old user when spec.userName is changed.
+	// +optional
+	UserName string `json:"userName,omitempty"`
+
+	// TokenUUID is the id of the API token issued for the user.
+	// +optional
+	TokenUUID string `json:"tokenUUID,omitempty"`
+
+	// TokenSecretName is the name of the Secret with the issued API token.
+	// +optional
+	TokenSecretName string `json:"tokenSecretName,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// JenkinsUser is a user of the Jenkins own user database managed by the operator.
+type JenkinsUser struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +optional
+	Spec JenkinsUserSpec `json:"spec,omitempty"`
+	// +optional
+	Status JenkinsUserStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// JenkinsUserList contains a list of JenkinsUser.
+type JenkinsUserList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []JenkinsUser `json:"items"`
+}
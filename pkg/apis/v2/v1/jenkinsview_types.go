@@ -0,0 +1,92 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ViewColumn is a column of the list view.
+// +kubebuilder:validation:Enum=status;weather;jobName;lastSuccess;lastFailure;lastStable;lastDuration;buildButton
+type ViewColumn string
+
+const (
+	ViewColumnStatus       ViewColumn = "status"
+	ViewColumnWeather      ViewColumn = "weather"
+	ViewColumnJobName      ViewColumn = "jobName"
+	ViewColumnLastSuccess  ViewColumn = "lastSuccess"
+	ViewColumnLastFailure  ViewColumn = "lastFailure"
+	ViewColumnLastStable   ViewColumn = "lastStable"
+	ViewColumnLastDuration ViewColumn = "lastDuration"
+	ViewColumnBuildButton  ViewColumn = "buildButton"
+)
+
+// JenkinsViewSpec defines the list view created in Jenkins.
+type JenkinsViewSpec struct {
+	// Name of the view in Jenkins.
+	Name string `json:"name"`
+
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// FolderName is the name of the JenkinsFolder the view is created in. The view is created in the Jenkins root if empty.
+	// +nullable
+	// +optional
+	FolderName *string `json:"folderName,omitempty"`
+
+	// Jobs are the names of the jobs listed in the view.
+	// +optional
+	Jobs []string `json:"jobs,omitempty"`
+
+	// IncludeRegex lists the jobs with the names matching the regular expression in addition to the jobs.
+	// +optional
+	IncludeRegex string `json:"includeRegex,omitempty"`
+
+	// Recurse lists the jobs of the nested folders.
+	// +optional
+	Recurse bool `json:"recurse,omitempty"`
+
+	// Columns of the view, the default Jenkins columns are used if empty.
+	// +optional
+	Columns []ViewColumn `json:"columns,omitempty"`
+
+	// +nullable
+	// +optional
+	OwnerName *string `json:"ownerName,omitempty"`
+}
+
+// JenkinsViewStatus defines the observed state of JenkinsView.
+type JenkinsViewStatus struct {
+	Value string `json:"value"`
+
+	// ViewName is the name of the view created in Jenkins.
+	// +optional
+	ViewName string `json:"viewName,omitempty"`
+
+	// Folder is the full name of the Jenkins folder the view has been created in.
+	// +optional
+	Folder string `json:"folder,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+
+// JenkinsView is the list view of Jenkins or a Jenkins folder.
+type JenkinsView struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +optional
+	Spec JenkinsViewSpec `json:"spec,omitempty"`
+	// +optional
+	Status JenkinsViewStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// JenkinsViewList contains a list of JenkinsView.
+type JenkinsViewList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []JenkinsView `json:"items"`
+}
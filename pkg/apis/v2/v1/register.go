@@ -24,15 +24,22 @@ var (
 func AddToScheme(sch *runtime.Scheme) error {
 	SchemeBuilder.Register(&JenkinsJob{}, &JenkinsJobList{},
 		&CDStageJenkinsDeployment{}, &CDStageJenkinsDeploymentList{},
+		&CDStageJenkinsDeploymentApproval{}, &CDStageJenkinsDeploymentApprovalList{},
 		&Jenkins{}, &JenkinsList{},
 		&JenkinsAgent{}, &JenkinsAgentList{},
+		&JenkinsAgentPool{}, &JenkinsAgentPoolList{},
+		&JenkinsAPIToken{}, &JenkinsAPITokenList{},
 		&JenkinsAuthorizationRole{}, &JenkinsAuthorizationRoleList{},
 		&JenkinsAuthorizationRoleMapping{}, &JenkinsAuthorizationRoleMappingList{},
+		&JenkinsConfigurationAsCode{}, &JenkinsConfigurationAsCodeList{},
 		&JenkinsFolder{}, &JenkinsFolderList{},
 		&JenkinsJobBuildRun{}, &JenkinsJobBuildRunList{},
+		&JenkinsMultibranchPipeline{}, &JenkinsMultibranchPipelineList{},
 		&JenkinsScript{}, &JenkinsScriptList{},
 		&JenkinsServiceAccount{}, &JenkinsServiceAccountList{},
-		&JenkinsSharedLibrary{}, &JenkinsSharedLibraryList{})
+		&JenkinsSharedLibrary{}, &JenkinsSharedLibraryList{},
+		&JenkinsUser{}, &JenkinsUserList{},
+		&JenkinsView{}, &JenkinsViewList{})
 
 	if err := SchemeBuilder.AddToScheme(sch); err != nil {
 		return fmt.Errorf("failed to build scheme: %w", err)
@@ -6,121 +6,92 @@
 package v1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CDStageJenkinsDeployment) DeepCopyInto(out *CDStageJenkinsDeployment) {
+func (in *AdminCredentialsRotation) DeepCopyInto(out *AdminCredentialsRotation) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CDStageJenkinsDeployment.
-func (in *CDStageJenkinsDeployment) DeepCopy() *CDStageJenkinsDeployment {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdminCredentialsRotation.
+func (in *AdminCredentialsRotation) DeepCopy() *AdminCredentialsRotation {
 	if in == nil {
 		return nil
 	}
-	out := new(CDStageJenkinsDeployment)
+	out := new(AdminCredentialsRotation)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CDStageJenkinsDeployment) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CDStageJenkinsDeploymentList) DeepCopyInto(out *CDStageJenkinsDeploymentList) {
+func (in *AdminCredentialsStatus) DeepCopyInto(out *AdminCredentialsStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]CDStageJenkinsDeployment, len(*in))
+	if in.LastRotationTime != nil {
+		in, out := &in.LastRotationTime, &out.LastRotationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextRotationTime != nil {
+		in, out := &in.NextRotationTime, &out.NextRotationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.PendingRevocations != nil {
+		in, out := &in.PendingRevocations, &out.PendingRevocations
+		*out = make([]PendingTokenRevocation, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CDStageJenkinsDeploymentList.
-func (in *CDStageJenkinsDeploymentList) DeepCopy() *CDStageJenkinsDeploymentList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdminCredentialsStatus.
+func (in *AdminCredentialsStatus) DeepCopy() *AdminCredentialsStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(CDStageJenkinsDeploymentList)
+	out := new(AdminCredentialsStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CDStageJenkinsDeploymentList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CDStageJenkinsDeploymentSpec) DeepCopyInto(out *CDStageJenkinsDeploymentSpec) {
+func (in *BranchDiscovery) DeepCopyInto(out *BranchDiscovery) {
 	*out = *in
-	out.Tag = in.Tag
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]Tag, len(*in))
-		copy(*out, *in)
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CDStageJenkinsDeploymentSpec.
-func (in *CDStageJenkinsDeploymentSpec) DeepCopy() *CDStageJenkinsDeploymentSpec {
-	if in == nil {
-		return nil
+	if in.Branches != nil {
+		in, out := &in.Branches, &out.Branches
+		*out = new(bool)
+		**out = **in
 	}
-	out := new(CDStageJenkinsDeploymentSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CDStageJenkinsDeploymentStatus) DeepCopyInto(out *CDStageJenkinsDeploymentStatus) {
-	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CDStageJenkinsDeploymentStatus.
-func (in *CDStageJenkinsDeploymentStatus) DeepCopy() *CDStageJenkinsDeploymentStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchDiscovery.
+func (in *BranchDiscovery) DeepCopy() *BranchDiscovery {
 	if in == nil {
 		return nil
 	}
-	out := new(CDStageJenkinsDeploymentStatus)
+	out := new(BranchDiscovery)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EdpSpec) DeepCopyInto(out *EdpSpec) {
+func (in *BranchSource) DeepCopyInto(out *BranchSource) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EdpSpec.
-func (in *EdpSpec) DeepCopy() *EdpSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchSource.
+func (in *BranchSource) DeepCopy() *BranchSource {
 	if in == nil {
 		return nil
 	}
-	out := new(EdpSpec)
+	out := new(BranchSource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Jenkins) DeepCopyInto(out *Jenkins) {
+func (in *CDStageJenkinsDeployment) DeepCopyInto(out *CDStageJenkinsDeployment) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -128,18 +99,18 @@ func (in *Jenkins) DeepCopyInto(out *Jenkins) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Jenkins.
-func (in *Jenkins) DeepCopy() *Jenkins {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CDStageJenkinsDeployment.
+func (in *CDStageJenkinsDeployment) DeepCopy() *CDStageJenkinsDeployment {
 	if in == nil {
 		return nil
 	}
-	out := new(Jenkins)
+	out := new(CDStageJenkinsDeployment)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Jenkins) DeepCopyObject() runtime.Object {
+func (in *CDStageJenkinsDeployment) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -147,26 +118,25 @@ func (in *Jenkins) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JenkinsAgent) DeepCopyInto(out *JenkinsAgent) {
+func (in *CDStageJenkinsDeploymentApproval) DeepCopyInto(out *CDStageJenkinsDeploymentApproval) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	out.Spec = in.Spec
-	out.Status = in.Status
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsAgent.
-func (in *JenkinsAgent) DeepCopy() *JenkinsAgent {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CDStageJenkinsDeploymentApproval.
+func (in *CDStageJenkinsDeploymentApproval) DeepCopy() *CDStageJenkinsDeploymentApproval {
 	if in == nil {
 		return nil
 	}
-	out := new(JenkinsAgent)
+	out := new(CDStageJenkinsDeploymentApproval)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *JenkinsAgent) DeepCopyObject() runtime.Object {
+func (in *CDStageJenkinsDeploymentApproval) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -174,31 +144,31 @@ func (in *JenkinsAgent) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JenkinsAgentList) DeepCopyInto(out *JenkinsAgentList) {
+func (in *CDStageJenkinsDeploymentApprovalList) DeepCopyInto(out *CDStageJenkinsDeploymentApprovalList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]JenkinsAgent, len(*in))
+		*out = make([]CDStageJenkinsDeploymentApproval, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsAgentList.
-func (in *JenkinsAgentList) DeepCopy() *JenkinsAgentList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CDStageJenkinsDeploymentApprovalList.
+func (in *CDStageJenkinsDeploymentApprovalList) DeepCopy() *CDStageJenkinsDeploymentApprovalList {
 	if in == nil {
 		return nil
 	}
-	out := new(JenkinsAgentList)
+	out := new(CDStageJenkinsDeploymentApprovalList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *JenkinsAgentList) DeepCopyObject() runtime.Object {
+func (in *CDStageJenkinsDeploymentApprovalList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -206,88 +176,46 @@ func (in *JenkinsAgentList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JenkinsAgentSpec) DeepCopyInto(out *JenkinsAgentSpec) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsAgentSpec.
-func (in *JenkinsAgentSpec) DeepCopy() *JenkinsAgentSpec {
-	if in == nil {
-		return nil
-	}
-	out := new(JenkinsAgentSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JenkinsAgentStatus) DeepCopyInto(out *JenkinsAgentStatus) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsAgentStatus.
-func (in *JenkinsAgentStatus) DeepCopy() *JenkinsAgentStatus {
-	if in == nil {
-		return nil
-	}
-	out := new(JenkinsAgentStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JenkinsAuthorizationRole) DeepCopyInto(out *JenkinsAuthorizationRole) {
+func (in *CDStageJenkinsDeploymentApprovalSpec) DeepCopyInto(out *CDStageJenkinsDeploymentApprovalSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsAuthorizationRole.
-func (in *JenkinsAuthorizationRole) DeepCopy() *JenkinsAuthorizationRole {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CDStageJenkinsDeploymentApprovalSpec.
+func (in *CDStageJenkinsDeploymentApprovalSpec) DeepCopy() *CDStageJenkinsDeploymentApprovalSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(JenkinsAuthorizationRole)
+	out := new(CDStageJenkinsDeploymentApprovalSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *JenkinsAuthorizationRole) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JenkinsAuthorizationRoleList) DeepCopyInto(out *JenkinsAuthorizationRoleList) {
+func (in *CDStageJenkinsDeploymentList) DeepCopyInto(out *CDStageJenkinsDeploymentList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]JenkinsAuthorizationRole, len(*in))
+		*out = make([]CDStageJenkinsDeployment, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsAuthorizationRoleList.
-func (in *JenkinsAuthorizationRoleList) DeepCopy() *JenkinsAuthorizationRoleList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CDStageJenkinsDeploymentList.
+func (in *CDStageJenkinsDeploymentList) DeepCopy() *CDStageJenkinsDeploymentList {
 	if in == nil {
 		return nil
 	}
-	out := new(JenkinsAuthorizationRoleList)
+	out := new(CDStageJenkinsDeploymentList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *JenkinsAuthorizationRoleList) DeepCopyObject() runtime.Object {
+func (in *CDStageJenkinsDeploymentList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -295,251 +223,261 @@ func (in *JenkinsAuthorizationRoleList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JenkinsAuthorizationRoleMapping) DeepCopyInto(out *JenkinsAuthorizationRoleMapping) {
+func (in *CDStageJenkinsDeploymentRollback) DeepCopyInto(out *CDStageJenkinsDeploymentRollback) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+	out.DeployBuild = in.DeployBuild
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsAuthorizationRoleMapping.
-func (in *JenkinsAuthorizationRoleMapping) DeepCopy() *JenkinsAuthorizationRoleMapping {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CDStageJenkinsDeploymentRollback.
+func (in *CDStageJenkinsDeploymentRollback) DeepCopy() *CDStageJenkinsDeploymentRollback {
 	if in == nil {
 		return nil
 	}
-	out := new(JenkinsAuthorizationRoleMapping)
+	out := new(CDStageJenkinsDeploymentRollback)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *JenkinsAuthorizationRoleMapping) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JenkinsAuthorizationRoleMappingList) DeepCopyInto(out *JenkinsAuthorizationRoleMappingList) {
+func (in *CDStageJenkinsDeploymentSpec) DeepCopyInto(out *CDStageJenkinsDeploymentSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]JenkinsAuthorizationRoleMapping, len(*in))
+	out.Tag = in.Tag
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+	if in.Params != nil {
+		in, out := &in.Params, &out.Params
+		*out = make([]DeployJobParam, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Jobs != nil {
+		in, out := &in.Jobs, &out.Jobs
+		*out = make([]DeployJob, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]DeploymentWindow, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsAuthorizationRoleMappingList.
-func (in *JenkinsAuthorizationRoleMappingList) DeepCopy() *JenkinsAuthorizationRoleMappingList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CDStageJenkinsDeploymentSpec.
+func (in *CDStageJenkinsDeploymentSpec) DeepCopy() *CDStageJenkinsDeploymentSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(JenkinsAuthorizationRoleMappingList)
+	out := new(CDStageJenkinsDeploymentSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *JenkinsAuthorizationRoleMappingList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CDStageJenkinsDeploymentStatus) DeepCopyInto(out *CDStageJenkinsDeploymentStatus) {
+	*out = *in
+	if in.Jobs != nil {
+		in, out := &in.Jobs, &out.Jobs
+		*out = make([]DeployJobStatus, len(*in))
+		copy(*out, *in)
 	}
-	return nil
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(CDStageJenkinsDeploymentRollback)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CDStageJenkinsDeploymentStatus.
+func (in *CDStageJenkinsDeploymentStatus) DeepCopy() *CDStageJenkinsDeploymentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CDStageJenkinsDeploymentStatus)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JenkinsAuthorizationRoleMappingSpec) DeepCopyInto(out *JenkinsAuthorizationRoleMappingSpec) {
+func (in *ConfigurationAsCodeSource) DeepCopyInto(out *ConfigurationAsCodeSource) {
 	*out = *in
-	if in.OwnerName != nil {
-		in, out := &in.OwnerName, &out.OwnerName
-		*out = new(string)
-		**out = **in
-	}
-	if in.Roles != nil {
-		in, out := &in.Roles, &out.Roles
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsAuthorizationRoleMappingSpec.
-func (in *JenkinsAuthorizationRoleMappingSpec) DeepCopy() *JenkinsAuthorizationRoleMappingSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationAsCodeSource.
+func (in *ConfigurationAsCodeSource) DeepCopy() *ConfigurationAsCodeSource {
 	if in == nil {
 		return nil
 	}
-	out := new(JenkinsAuthorizationRoleMappingSpec)
+	out := new(ConfigurationAsCodeSource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JenkinsAuthorizationRoleMappingStatus) DeepCopyInto(out *JenkinsAuthorizationRoleMappingStatus) {
+func (in *DeployBuild) DeepCopyInto(out *DeployBuild) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsAuthorizationRoleMappingStatus.
-func (in *JenkinsAuthorizationRoleMappingStatus) DeepCopy() *JenkinsAuthorizationRoleMappingStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployBuild.
+func (in *DeployBuild) DeepCopy() *DeployBuild {
 	if in == nil {
 		return nil
 	}
-	out := new(JenkinsAuthorizationRoleMappingStatus)
+	out := new(DeployBuild)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JenkinsAuthorizationRoleSpec) DeepCopyInto(out *JenkinsAuthorizationRoleSpec) {
+func (in *DeployJob) DeepCopyInto(out *DeployJob) {
 	*out = *in
-	if in.Permissions != nil {
-		in, out := &in.Permissions, &out.Permissions
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.OwnerName != nil {
-		in, out := &in.OwnerName, &out.OwnerName
-		*out = new(string)
-		**out = **in
+	if in.Params != nil {
+		in, out := &in.Params, &out.Params
+		*out = make([]DeployJobParam, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsAuthorizationRoleSpec.
-func (in *JenkinsAuthorizationRoleSpec) DeepCopy() *JenkinsAuthorizationRoleSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployJob.
+func (in *DeployJob) DeepCopy() *DeployJob {
 	if in == nil {
 		return nil
 	}
-	out := new(JenkinsAuthorizationRoleSpec)
+	out := new(DeployJob)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JenkinsAuthorizationRoleStatus) DeepCopyInto(out *JenkinsAuthorizationRoleStatus) {
+func (in *DeployJobParam) DeepCopyInto(out *DeployJobParam) {
 	*out = *in
+	if in.ValueFrom != nil {
+		in, out := &in.ValueFrom, &out.ValueFrom
+		*out = new(DeployJobParamSource)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsAuthorizationRoleStatus.
-func (in *JenkinsAuthorizationRoleStatus) DeepCopy() *JenkinsAuthorizationRoleStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployJobParam.
+func (in *DeployJobParam) DeepCopy() *DeployJobParam {
 	if in == nil {
 		return nil
 	}
-	out := new(JenkinsAuthorizationRoleStatus)
+	out := new(DeployJobParam)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JenkinsFolder) DeepCopyInto(out *JenkinsFolder) {
+func (in *DeployJobParamSource) DeepCopyInto(out *DeployJobParamSource) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(corev1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsFolder.
-func (in *JenkinsFolder) DeepCopy() *JenkinsFolder {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployJobParamSource.
+func (in *DeployJobParamSource) DeepCopy() *DeployJobParamSource {
 	if in == nil {
 		return nil
 	}
-	out := new(JenkinsFolder)
+	out := new(DeployJobParamSource)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *JenkinsFolder) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JenkinsFolderList) DeepCopyInto(out *JenkinsFolderList) {
+func (in *DeployJobStatus) DeepCopyInto(out *DeployJobStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]JenkinsFolder, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	out.DeployBuild = in.DeployBuild
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsFolderList.
-func (in *JenkinsFolderList) DeepCopy() *JenkinsFolderList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployJobStatus.
+func (in *DeployJobStatus) DeepCopy() *DeployJobStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(JenkinsFolderList)
+	out := new(DeployJobStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *JenkinsFolderList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentWindow) DeepCopyInto(out *DeploymentWindow) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentWindow.
+func (in *DeploymentWindow) DeepCopy() *DeploymentWindow {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(DeploymentWindow)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JenkinsFolderSpec) DeepCopyInto(out *JenkinsFolderSpec) {
+func (in *EdpSpec) DeepCopyInto(out *EdpSpec) {
 	*out = *in
-	if in.CodebaseName != nil {
-		in, out := &in.CodebaseName, &out.CodebaseName
-		*out = new(string)
-		**out = **in
-	}
-	if in.OwnerName != nil {
-		in, out := &in.OwnerName, &out.OwnerName
-		*out = new(string)
-		**out = **in
-	}
-	if in.Job != nil {
-		in, out := &in.Job, &out.Job
-		*out = new(Job)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsFolderSpec.
-func (in *JenkinsFolderSpec) DeepCopy() *JenkinsFolderSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EdpSpec.
+func (in *EdpSpec) DeepCopy() *EdpSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(JenkinsFolderSpec)
+	out := new(EdpSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JenkinsFolderStatus) DeepCopyInto(out *JenkinsFolderStatus) {
+func (in *FolderPermission) DeepCopyInto(out *FolderPermission) {
 	*out = *in
-	in.LastTimeUpdated.DeepCopyInto(&out.LastTimeUpdated)
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsFolderStatus.
-func (in *JenkinsFolderStatus) DeepCopy() *JenkinsFolderStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FolderPermission.
+func (in *FolderPermission) DeepCopy() *FolderPermission {
 	if in == nil {
 		return nil
 	}
-	out := new(JenkinsFolderStatus)
+	out := new(FolderPermission)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JenkinsJob) DeepCopyInto(out *JenkinsJob) {
+func (in *Jenkins) DeepCopyInto(out *Jenkins) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -547,18 +485,18 @@ func (in *JenkinsJob) DeepCopyInto(out *JenkinsJob) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsJob.
-func (in *JenkinsJob) DeepCopy() *JenkinsJob {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Jenkins.
+func (in *Jenkins) DeepCopy() *Jenkins {
 	if in == nil {
 		return nil
 	}
-	out := new(JenkinsJob)
+	out := new(Jenkins)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *JenkinsJob) DeepCopyObject() runtime.Object {
+func (in *Jenkins) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -566,7 +504,7 @@ func (in *JenkinsJob) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JenkinsJobBuildRun) DeepCopyInto(out *JenkinsJobBuildRun) {
+func (in *JenkinsAPIToken) DeepCopyInto(out *JenkinsAPIToken) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -574,18 +512,18 @@ func (in *JenkinsJobBuildRun) DeepCopyInto(out *JenkinsJobBuildRun) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsJobBuildRun.
-func (in *JenkinsJobBuildRun) DeepCopy() *JenkinsJobBuildRun {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsAPIToken.
+func (in *JenkinsAPIToken) DeepCopy() *JenkinsAPIToken {
 	if in == nil {
 		return nil
 	}
-	out := new(JenkinsJobBuildRun)
+	out := new(JenkinsAPIToken)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *JenkinsJobBuildRun) DeepCopyObject() runtime.Object {
+func (in *JenkinsAPIToken) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -593,31 +531,31 @@ func (in *JenkinsJobBuildRun) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JenkinsJobBuildRunList) DeepCopyInto(out *JenkinsJobBuildRunList) {
+func (in *JenkinsAPITokenList) DeepCopyInto(out *JenkinsAPITokenList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]JenkinsJobBuildRun, len(*in))
+		*out = make([]JenkinsAPIToken, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsJobBuildRunList.
-func (in *JenkinsJobBuildRunList) DeepCopy() *JenkinsJobBuildRunList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsAPITokenList.
+func (in *JenkinsAPITokenList) DeepCopy() *JenkinsAPITokenList {
 	if in == nil {
 		return nil
 	}
-	out := new(JenkinsJobBuildRunList)
+	out := new(JenkinsAPITokenList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *JenkinsJobBuildRunList) DeepCopyObject() runtime.Object {
+func (in *JenkinsAPITokenList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -625,79 +563,106 @@ func (in *JenkinsJobBuildRunList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JenkinsJobBuildRunSpec) DeepCopyInto(out *JenkinsJobBuildRunSpec) {
+func (in *JenkinsAPITokenSpec) DeepCopyInto(out *JenkinsAPITokenSpec) {
 	*out = *in
-	if in.Params != nil {
-		in, out := &in.Params, &out.Params
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
 	if in.OwnerName != nil {
 		in, out := &in.OwnerName, &out.OwnerName
 		*out = new(string)
 		**out = **in
 	}
-	if in.DeleteAfterCompletionInterval != nil {
-		in, out := &in.DeleteAfterCompletionInterval, &out.DeleteAfterCompletionInterval
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsAPITokenSpec.
+func (in *JenkinsAPITokenSpec) DeepCopy() *JenkinsAPITokenSpec {
+	if in == nil {
+		return nil
 	}
+	out := new(JenkinsAPITokenSpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsJobBuildRunSpec.
-func (in *JenkinsJobBuildRunSpec) DeepCopy() *JenkinsJobBuildRunSpec {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsAPITokenStatus) DeepCopyInto(out *JenkinsAPITokenStatus) {
+	*out = *in
+	if in.IssuedAt != nil {
+		in, out := &in.IssuedAt, &out.IssuedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.NextRotationTime != nil {
+		in, out := &in.NextRotationTime, &out.NextRotationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.PendingRevocation != nil {
+		in, out := &in.PendingRevocation, &out.PendingRevocation
+		*out = make([]ReplacedAPIToken, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsAPITokenStatus.
+func (in *JenkinsAPITokenStatus) DeepCopy() *JenkinsAPITokenStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(JenkinsJobBuildRunSpec)
+	out := new(JenkinsAPITokenStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JenkinsJobBuildRunStatus) DeepCopyInto(out *JenkinsJobBuildRunStatus) {
+func (in *JenkinsAgent) DeepCopyInto(out *JenkinsAgent) {
 	*out = *in
-	in.LastUpdated.DeepCopyInto(&out.LastUpdated)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsJobBuildRunStatus.
-func (in *JenkinsJobBuildRunStatus) DeepCopy() *JenkinsJobBuildRunStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsAgent.
+func (in *JenkinsAgent) DeepCopy() *JenkinsAgent {
 	if in == nil {
 		return nil
 	}
-	out := new(JenkinsJobBuildRunStatus)
+	out := new(JenkinsAgent)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JenkinsAgent) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JenkinsJobList) DeepCopyInto(out *JenkinsJobList) {
+func (in *JenkinsAgentList) DeepCopyInto(out *JenkinsAgentList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]JenkinsJob, len(*in))
+		*out = make([]JenkinsAgent, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsJobList.
-func (in *JenkinsJobList) DeepCopy() *JenkinsJobList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsAgentList.
+func (in *JenkinsAgentList) DeepCopy() *JenkinsAgentList {
 	if in == nil {
 		return nil
 	}
-	out := new(JenkinsJobList)
+	out := new(JenkinsAgentList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *JenkinsJobList) DeepCopyObject() runtime.Object {
+func (in *JenkinsAgentList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -705,105 +670,1557 @@ func (in *JenkinsJobList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JenkinsJobSpec) DeepCopyInto(out *JenkinsJobSpec) {
+func (in *JenkinsAgentPodTemplate) DeepCopyInto(out *JenkinsAgentPodTemplate) {
 	*out = *in
-	if in.OwnerName != nil {
-		in, out := &in.OwnerName, &out.OwnerName
-		*out = new(string)
-		**out = **in
-	}
-	if in.StageName != nil {
-		in, out := &in.StageName, &out.StageName
-		*out = new(string)
-		**out = **in
-	}
-	if in.JenkinsFolder != nil {
-		in, out := &in.JenkinsFolder, &out.JenkinsFolder
-		*out = new(string)
+	if in.InstanceCap != nil {
+		in, out := &in.InstanceCap, &out.InstanceCap
+		*out = new(int32)
 		**out = **in
 	}
-	in.Job.DeepCopyInto(&out.Job)
+	in.Template.DeepCopyInto(&out.Template)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsJobSpec.
-func (in *JenkinsJobSpec) DeepCopy() *JenkinsJobSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsAgentPodTemplate.
+func (in *JenkinsAgentPodTemplate) DeepCopy() *JenkinsAgentPodTemplate {
 	if in == nil {
 		return nil
 	}
-	out := new(JenkinsJobSpec)
+	out := new(JenkinsAgentPodTemplate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JenkinsJobStatus) DeepCopyInto(out *JenkinsJobStatus) {
+func (in *JenkinsAgentPool) DeepCopyInto(out *JenkinsAgentPool) {
 	*out = *in
-	in.LastTimeUpdated.DeepCopyInto(&out.LastTimeUpdated)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsJobStatus.
-func (in *JenkinsJobStatus) DeepCopy() *JenkinsJobStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsAgentPool.
+func (in *JenkinsAgentPool) DeepCopy() *JenkinsAgentPool {
 	if in == nil {
 		return nil
 	}
-	out := new(JenkinsJobStatus)
+	out := new(JenkinsAgentPool)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JenkinsAgentPool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JenkinsList) DeepCopyInto(out *JenkinsList) {
+func (in *JenkinsAgentPoolList) DeepCopyInto(out *JenkinsAgentPoolList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Jenkins, len(*in))
+		*out = make([]JenkinsAgentPool, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsList.
-func (in *JenkinsList) DeepCopy() *JenkinsList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsAgentPoolList.
+func (in *JenkinsAgentPoolList) DeepCopy() *JenkinsAgentPoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsAgentPoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JenkinsAgentPoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsAgentPoolSpec) DeepCopyInto(out *JenkinsAgentPoolSpec) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Executors != nil {
+		in, out := &in.Executors, &out.Executors
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(corev1.PodTemplateSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OwnerName != nil {
+		in, out := &in.OwnerName, &out.OwnerName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsAgentPoolSpec.
+func (in *JenkinsAgentPoolSpec) DeepCopy() *JenkinsAgentPoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsAgentPoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsAgentPoolStatus) DeepCopyInto(out *JenkinsAgentPoolStatus) {
+	*out = *in
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsAgentPoolStatus.
+func (in *JenkinsAgentPoolStatus) DeepCopy() *JenkinsAgentPoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsAgentPoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsAgentSpec) DeepCopyInto(out *JenkinsAgentSpec) {
+	*out = *in
+	if in.PodTemplate != nil {
+		in, out := &in.PodTemplate, &out.PodTemplate
+		*out = new(JenkinsAgentPodTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OwnerName != nil {
+		in, out := &in.OwnerName, &out.OwnerName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsAgentSpec.
+func (in *JenkinsAgentSpec) DeepCopy() *JenkinsAgentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsAgentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsAgentStatus) DeepCopyInto(out *JenkinsAgentStatus) {
+	*out = *in
+	if in.Errors != nil {
+		in, out := &in.Errors, &out.Errors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastSeen != nil {
+		in, out := &in.LastSeen, &out.LastSeen
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsAgentStatus.
+func (in *JenkinsAgentStatus) DeepCopy() *JenkinsAgentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsAgentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsAuthorizationRole) DeepCopyInto(out *JenkinsAuthorizationRole) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsAuthorizationRole.
+func (in *JenkinsAuthorizationRole) DeepCopy() *JenkinsAuthorizationRole {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsAuthorizationRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JenkinsAuthorizationRole) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsAuthorizationRoleList) DeepCopyInto(out *JenkinsAuthorizationRoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]JenkinsAuthorizationRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsAuthorizationRoleList.
+func (in *JenkinsAuthorizationRoleList) DeepCopy() *JenkinsAuthorizationRoleList {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsAuthorizationRoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JenkinsAuthorizationRoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsAuthorizationRoleMapping) DeepCopyInto(out *JenkinsAuthorizationRoleMapping) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsAuthorizationRoleMapping.
+func (in *JenkinsAuthorizationRoleMapping) DeepCopy() *JenkinsAuthorizationRoleMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsAuthorizationRoleMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JenkinsAuthorizationRoleMapping) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsAuthorizationRoleMappingList) DeepCopyInto(out *JenkinsAuthorizationRoleMappingList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]JenkinsAuthorizationRoleMapping, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsAuthorizationRoleMappingList.
+func (in *JenkinsAuthorizationRoleMappingList) DeepCopy() *JenkinsAuthorizationRoleMappingList {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsAuthorizationRoleMappingList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JenkinsAuthorizationRoleMappingList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsAuthorizationRoleMappingSpec) DeepCopyInto(out *JenkinsAuthorizationRoleMappingSpec) {
+	*out = *in
+	if in.OwnerName != nil {
+		in, out := &in.OwnerName, &out.OwnerName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsAuthorizationRoleMappingSpec.
+func (in *JenkinsAuthorizationRoleMappingSpec) DeepCopy() *JenkinsAuthorizationRoleMappingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsAuthorizationRoleMappingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsAuthorizationRoleMappingStatus) DeepCopyInto(out *JenkinsAuthorizationRoleMappingStatus) {
+	*out = *in
+	if in.Assigned != nil {
+		in, out := &in.Assigned, &out.Assigned
+		*out = make([]RoleAssignment, len(*in))
+		copy(*out, *in)
+	}
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]RoleAssignmentResult, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsAuthorizationRoleMappingStatus.
+func (in *JenkinsAuthorizationRoleMappingStatus) DeepCopy() *JenkinsAuthorizationRoleMappingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsAuthorizationRoleMappingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsAuthorizationRoleSpec) DeepCopyInto(out *JenkinsAuthorizationRoleSpec) {
+	*out = *in
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OwnerName != nil {
+		in, out := &in.OwnerName, &out.OwnerName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsAuthorizationRoleSpec.
+func (in *JenkinsAuthorizationRoleSpec) DeepCopy() *JenkinsAuthorizationRoleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsAuthorizationRoleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsAuthorizationRoleStatus) DeepCopyInto(out *JenkinsAuthorizationRoleStatus) {
+	*out = *in
+	if in.InvalidPermissions != nil {
+		in, out := &in.InvalidPermissions, &out.InvalidPermissions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsAuthorizationRoleStatus.
+func (in *JenkinsAuthorizationRoleStatus) DeepCopy() *JenkinsAuthorizationRoleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsAuthorizationRoleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsConfigurationAsCode) DeepCopyInto(out *JenkinsConfigurationAsCode) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsConfigurationAsCode.
+func (in *JenkinsConfigurationAsCode) DeepCopy() *JenkinsConfigurationAsCode {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsConfigurationAsCode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JenkinsConfigurationAsCode) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsConfigurationAsCodeList) DeepCopyInto(out *JenkinsConfigurationAsCodeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]JenkinsConfigurationAsCode, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsConfigurationAsCodeList.
+func (in *JenkinsConfigurationAsCodeList) DeepCopy() *JenkinsConfigurationAsCodeList {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsConfigurationAsCodeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JenkinsConfigurationAsCodeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsConfigurationAsCodeSpec) DeepCopyInto(out *JenkinsConfigurationAsCodeSpec) {
+	*out = *in
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]ConfigurationAsCodeSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OwnerName != nil {
+		in, out := &in.OwnerName, &out.OwnerName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsConfigurationAsCodeSpec.
+func (in *JenkinsConfigurationAsCodeSpec) DeepCopy() *JenkinsConfigurationAsCodeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsConfigurationAsCodeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsConfigurationAsCodeStatus) DeepCopyInto(out *JenkinsConfigurationAsCodeStatus) {
+	*out = *in
+	if in.LastAppliedTime != nil {
+		in, out := &in.LastAppliedTime, &out.LastAppliedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Warnings != nil {
+		in, out := &in.Warnings, &out.Warnings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsConfigurationAsCodeStatus.
+func (in *JenkinsConfigurationAsCodeStatus) DeepCopy() *JenkinsConfigurationAsCodeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsConfigurationAsCodeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsFolder) DeepCopyInto(out *JenkinsFolder) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsFolder.
+func (in *JenkinsFolder) DeepCopy() *JenkinsFolder {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsFolder)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JenkinsFolder) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsFolderList) DeepCopyInto(out *JenkinsFolderList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]JenkinsFolder, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsFolderList.
+func (in *JenkinsFolderList) DeepCopy() *JenkinsFolderList {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsFolderList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JenkinsFolderList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsFolderSpec) DeepCopyInto(out *JenkinsFolderSpec) {
+	*out = *in
+	if in.CodebaseName != nil {
+		in, out := &in.CodebaseName, &out.CodebaseName
+		*out = new(string)
+		**out = **in
+	}
+	if in.OwnerName != nil {
+		in, out := &in.OwnerName, &out.OwnerName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Job != nil {
+		in, out := &in.Job, &out.Job
+		*out = new(Job)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = make([]FolderPermission, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsFolderSpec.
+func (in *JenkinsFolderSpec) DeepCopy() *JenkinsFolderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsFolderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsFolderStatus) DeepCopyInto(out *JenkinsFolderStatus) {
+	*out = *in
+	in.LastTimeUpdated.DeepCopyInto(&out.LastTimeUpdated)
+	if in.PermissionRoles != nil {
+		in, out := &in.PermissionRoles, &out.PermissionRoles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsFolderStatus.
+func (in *JenkinsFolderStatus) DeepCopy() *JenkinsFolderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsFolderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsJob) DeepCopyInto(out *JenkinsJob) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsJob.
+func (in *JenkinsJob) DeepCopy() *JenkinsJob {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsJob)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JenkinsJob) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsJobBuildRun) DeepCopyInto(out *JenkinsJobBuildRun) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsJobBuildRun.
+func (in *JenkinsJobBuildRun) DeepCopy() *JenkinsJobBuildRun {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsJobBuildRun)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JenkinsJobBuildRun) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsJobBuildRunDependency) DeepCopyInto(out *JenkinsJobBuildRunDependency) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsJobBuildRunDependency.
+func (in *JenkinsJobBuildRunDependency) DeepCopy() *JenkinsJobBuildRunDependency {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsJobBuildRunDependency)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsJobBuildRunList) DeepCopyInto(out *JenkinsJobBuildRunList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]JenkinsJobBuildRun, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsJobBuildRunList.
+func (in *JenkinsJobBuildRunList) DeepCopy() *JenkinsJobBuildRunList {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsJobBuildRunList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JenkinsJobBuildRunList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsJobBuildRunSpec) DeepCopyInto(out *JenkinsJobBuildRunSpec) {
+	*out = *in
+	if in.Params != nil {
+		in, out := &in.Params, &out.Params
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.OwnerName != nil {
+		in, out := &in.OwnerName, &out.OwnerName
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeleteAfterCompletionInterval != nil {
+		in, out := &in.DeleteAfterCompletionInterval, &out.DeleteAfterCompletionInterval
+		*out = new(string)
+		**out = **in
+	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]JenkinsJobBuildRunDependency, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsJobBuildRunSpec.
+func (in *JenkinsJobBuildRunSpec) DeepCopy() *JenkinsJobBuildRunSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsJobBuildRunSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsJobBuildRunStatus) DeepCopyInto(out *JenkinsJobBuildRunStatus) {
+	*out = *in
+	in.LastUpdated.DeepCopyInto(&out.LastUpdated)
+	if in.UpstreamBuilds != nil {
+		in, out := &in.UpstreamBuilds, &out.UpstreamBuilds
+		*out = make(map[string]int64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsJobBuildRunStatus.
+func (in *JenkinsJobBuildRunStatus) DeepCopy() *JenkinsJobBuildRunStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsJobBuildRunStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsJobList) DeepCopyInto(out *JenkinsJobList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]JenkinsJob, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsJobList.
+func (in *JenkinsJobList) DeepCopy() *JenkinsJobList {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsJobList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JenkinsJobList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsJobSpec) DeepCopyInto(out *JenkinsJobSpec) {
+	*out = *in
+	if in.OwnerName != nil {
+		in, out := &in.OwnerName, &out.OwnerName
+		*out = new(string)
+		**out = **in
+	}
+	if in.StageName != nil {
+		in, out := &in.StageName, &out.StageName
+		*out = new(string)
+		**out = **in
+	}
+	if in.JenkinsFolder != nil {
+		in, out := &in.JenkinsFolder, &out.JenkinsFolder
+		*out = new(string)
+		**out = **in
+	}
+	in.Job.DeepCopyInto(&out.Job)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsJobSpec.
+func (in *JenkinsJobSpec) DeepCopy() *JenkinsJobSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsJobSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsJobStatus) DeepCopyInto(out *JenkinsJobStatus) {
+	*out = *in
+	in.LastTimeUpdated.DeepCopyInto(&out.LastTimeUpdated)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsJobStatus.
+func (in *JenkinsJobStatus) DeepCopy() *JenkinsJobStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsJobStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsList) DeepCopyInto(out *JenkinsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Jenkins, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsList.
+func (in *JenkinsList) DeepCopy() *JenkinsList {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JenkinsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsMultibranchPipeline) DeepCopyInto(out *JenkinsMultibranchPipeline) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsMultibranchPipeline.
+func (in *JenkinsMultibranchPipeline) DeepCopy() *JenkinsMultibranchPipeline {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsMultibranchPipeline)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JenkinsMultibranchPipeline) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsMultibranchPipelineList) DeepCopyInto(out *JenkinsMultibranchPipelineList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]JenkinsMultibranchPipeline, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsMultibranchPipelineList.
+func (in *JenkinsMultibranchPipelineList) DeepCopy() *JenkinsMultibranchPipelineList {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsMultibranchPipelineList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JenkinsMultibranchPipelineList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsMultibranchPipelineSpec) DeepCopyInto(out *JenkinsMultibranchPipelineSpec) {
+	*out = *in
+	if in.FolderName != nil {
+		in, out := &in.FolderName, &out.FolderName
+		*out = new(string)
+		**out = **in
+	}
+	out.Source = in.Source
+	in.Discovery.DeepCopyInto(&out.Discovery)
+	if in.OrphanedItemStrategy != nil {
+		in, out := &in.OrphanedItemStrategy, &out.OrphanedItemStrategy
+		*out = new(OrphanedItemStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OwnerName != nil {
+		in, out := &in.OwnerName, &out.OwnerName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsMultibranchPipelineSpec.
+func (in *JenkinsMultibranchPipelineSpec) DeepCopy() *JenkinsMultibranchPipelineSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsMultibranchPipelineSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsMultibranchPipelineStatus) DeepCopyInto(out *JenkinsMultibranchPipelineStatus) {
+	*out = *in
+	if in.LastScanTime != nil {
+		in, out := &in.LastScanTime, &out.LastScanTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsMultibranchPipelineStatus.
+func (in *JenkinsMultibranchPipelineStatus) DeepCopy() *JenkinsMultibranchPipelineStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsMultibranchPipelineStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsPlugin) DeepCopyInto(out *JenkinsPlugin) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsPlugin.
+func (in *JenkinsPlugin) DeepCopy() *JenkinsPlugin {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsPlugin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsScript) DeepCopyInto(out *JenkinsScript) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsScript.
+func (in *JenkinsScript) DeepCopy() *JenkinsScript {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsScript)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JenkinsScript) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsScriptList) DeepCopyInto(out *JenkinsScriptList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]JenkinsScript, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsScriptList.
+func (in *JenkinsScriptList) DeepCopy() *JenkinsScriptList {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsScriptList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JenkinsScriptList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsScriptSpec) DeepCopyInto(out *JenkinsScriptSpec) {
+	*out = *in
+	if in.OwnerName != nil {
+		in, out := &in.OwnerName, &out.OwnerName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsScriptSpec.
+func (in *JenkinsScriptSpec) DeepCopy() *JenkinsScriptSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsScriptSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsScriptStatus) DeepCopyInto(out *JenkinsScriptStatus) {
+	*out = *in
+	in.LastTimeUpdated.DeepCopyInto(&out.LastTimeUpdated)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsScriptStatus.
+func (in *JenkinsScriptStatus) DeepCopy() *JenkinsScriptStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsScriptStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsServiceAccount) DeepCopyInto(out *JenkinsServiceAccount) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsServiceAccount.
+func (in *JenkinsServiceAccount) DeepCopy() *JenkinsServiceAccount {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsServiceAccount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JenkinsServiceAccount) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsServiceAccountList) DeepCopyInto(out *JenkinsServiceAccountList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]JenkinsServiceAccount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsServiceAccountList.
+func (in *JenkinsServiceAccountList) DeepCopy() *JenkinsServiceAccountList {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsServiceAccountList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JenkinsServiceAccountList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsServiceAccountSpec) DeepCopyInto(out *JenkinsServiceAccountSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsServiceAccountSpec.
+func (in *JenkinsServiceAccountSpec) DeepCopy() *JenkinsServiceAccountSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsServiceAccountSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsServiceAccountStatus) DeepCopyInto(out *JenkinsServiceAccountStatus) {
+	*out = *in
+	in.LastTimeUpdated.DeepCopyInto(&out.LastTimeUpdated)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsServiceAccountStatus.
+func (in *JenkinsServiceAccountStatus) DeepCopy() *JenkinsServiceAccountStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsServiceAccountStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsSharedLibraries) DeepCopyInto(out *JenkinsSharedLibraries) {
+	*out = *in
+	if in.CredentialID != nil {
+		in, out := &in.CredentialID, &out.CredentialID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsSharedLibraries.
+func (in *JenkinsSharedLibraries) DeepCopy() *JenkinsSharedLibraries {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsSharedLibraries)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsSharedLibrary) DeepCopyInto(out *JenkinsSharedLibrary) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsSharedLibrary.
+func (in *JenkinsSharedLibrary) DeepCopy() *JenkinsSharedLibrary {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsSharedLibrary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JenkinsSharedLibrary) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsSharedLibraryList) DeepCopyInto(out *JenkinsSharedLibraryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]JenkinsSharedLibrary, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsSharedLibraryList.
+func (in *JenkinsSharedLibraryList) DeepCopy() *JenkinsSharedLibraryList {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsSharedLibraryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JenkinsSharedLibraryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsSharedLibrarySpec) DeepCopyInto(out *JenkinsSharedLibrarySpec) {
+	*out = *in
+	if in.OwnerName != nil {
+		in, out := &in.OwnerName, &out.OwnerName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsSharedLibrarySpec.
+func (in *JenkinsSharedLibrarySpec) DeepCopy() *JenkinsSharedLibrarySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsSharedLibrarySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsSharedLibraryStatus) DeepCopyInto(out *JenkinsSharedLibraryStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsSharedLibraryStatus.
+func (in *JenkinsSharedLibraryStatus) DeepCopy() *JenkinsSharedLibraryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsSharedLibraryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsSpec) DeepCopyInto(out *JenkinsSpec) {
+	*out = *in
+	if in.SharedLibraries != nil {
+		in, out := &in.SharedLibraries, &out.SharedLibraries
+		*out = make([]JenkinsSharedLibraries, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.KeycloakSpec.DeepCopyInto(&out.KeycloakSpec)
+	out.EdpSpec = in.EdpSpec
+	if in.SecurityRealm != nil {
+		in, out := &in.SecurityRealm, &out.SecurityRealm
+		*out = new(SecurityRealm)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdminCredentialsRotation != nil {
+		in, out := &in.AdminCredentialsRotation, &out.AdminCredentialsRotation
+		*out = new(AdminCredentialsRotation)
+		**out = **in
+	}
+	if in.PluginManagement != nil {
+		in, out := &in.PluginManagement, &out.PluginManagement
+		*out = new(PluginManagement)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubernetesClouds != nil {
+		in, out := &in.KubernetesClouds, &out.KubernetesClouds
+		*out = make([]KubernetesCloud, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsSpec.
+func (in *JenkinsSpec) DeepCopy() *JenkinsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsStatus) DeepCopyInto(out *JenkinsStatus) {
+	*out = *in
+	in.LastTimeUpdated.DeepCopyInto(&out.LastTimeUpdated)
+	if in.Slaves != nil {
+		in, out := &in.Slaves, &out.Slaves
+		*out = make([]Slave, len(*in))
+		copy(*out, *in)
+	}
+	if in.JobProvisions != nil {
+		in, out := &in.JobProvisions, &out.JobProvisions
+		*out = make([]JobProvision, len(*in))
+		copy(*out, *in)
+	}
+	if in.AdminCredentials != nil {
+		in, out := &in.AdminCredentials, &out.AdminCredentials
+		*out = new(AdminCredentialsStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Plugins != nil {
+		in, out := &in.Plugins, &out.Plugins
+		*out = new(PluginsStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubernetesClouds != nil {
+		in, out := &in.KubernetesClouds, &out.KubernetesClouds
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsStatus.
+func (in *JenkinsStatus) DeepCopy() *JenkinsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsUser) DeepCopyInto(out *JenkinsUser) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsUser.
+func (in *JenkinsUser) DeepCopy() *JenkinsUser {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JenkinsUser) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsUserAPIToken) DeepCopyInto(out *JenkinsUserAPIToken) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsUserAPIToken.
+func (in *JenkinsUserAPIToken) DeepCopy() *JenkinsUserAPIToken {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsUserAPIToken)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsUserList) DeepCopyInto(out *JenkinsUserList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]JenkinsUser, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsUserList.
+func (in *JenkinsUserList) DeepCopy() *JenkinsUserList {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsUserList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JenkinsUserList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsUserSpec) DeepCopyInto(out *JenkinsUserSpec) {
+	*out = *in
+	in.PasswordRef.DeepCopyInto(&out.PasswordRef)
+	if in.APIToken != nil {
+		in, out := &in.APIToken, &out.APIToken
+		*out = new(JenkinsUserAPIToken)
+		**out = **in
+	}
+	if in.OwnerName != nil {
+		in, out := &in.OwnerName, &out.OwnerName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsUserSpec.
+func (in *JenkinsUserSpec) DeepCopy() *JenkinsUserSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JenkinsUserSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JenkinsUserStatus) DeepCopyInto(out *JenkinsUserStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsUserStatus.
+func (in *JenkinsUserStatus) DeepCopy() *JenkinsUserStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(JenkinsList)
+	out := new(JenkinsUserStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *JenkinsList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JenkinsScript) DeepCopyInto(out *JenkinsScript) {
+func (in *JenkinsView) DeepCopyInto(out *JenkinsView) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	out.Status = in.Status
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsScript.
-func (in *JenkinsScript) DeepCopy() *JenkinsScript {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsView.
+func (in *JenkinsView) DeepCopy() *JenkinsView {
 	if in == nil {
 		return nil
 	}
-	out := new(JenkinsScript)
+	out := new(JenkinsView)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *JenkinsScript) DeepCopyObject() runtime.Object {
+func (in *JenkinsView) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -811,31 +2228,31 @@ func (in *JenkinsScript) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JenkinsScriptList) DeepCopyInto(out *JenkinsScriptList) {
+func (in *JenkinsViewList) DeepCopyInto(out *JenkinsViewList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]JenkinsScript, len(*in))
+		*out = make([]JenkinsView, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsScriptList.
-func (in *JenkinsScriptList) DeepCopy() *JenkinsScriptList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsViewList.
+func (in *JenkinsViewList) DeepCopy() *JenkinsViewList {
 	if in == nil {
 		return nil
 	}
-	out := new(JenkinsScriptList)
+	out := new(JenkinsViewList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *JenkinsScriptList) DeepCopyObject() runtime.Object {
+func (in *JenkinsViewList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -843,8 +2260,23 @@ func (in *JenkinsScriptList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JenkinsScriptSpec) DeepCopyInto(out *JenkinsScriptSpec) {
+func (in *JenkinsViewSpec) DeepCopyInto(out *JenkinsViewSpec) {
 	*out = *in
+	if in.FolderName != nil {
+		in, out := &in.FolderName, &out.FolderName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Jobs != nil {
+		in, out := &in.Jobs, &out.Jobs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Columns != nil {
+		in, out := &in.Columns, &out.Columns
+		*out = make([]ViewColumn, len(*in))
+		copy(*out, *in)
+	}
 	if in.OwnerName != nil {
 		in, out := &in.OwnerName, &out.OwnerName
 		*out = new(string)
@@ -852,337 +2284,367 @@ func (in *JenkinsScriptSpec) DeepCopyInto(out *JenkinsScriptSpec) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsScriptSpec.
-func (in *JenkinsScriptSpec) DeepCopy() *JenkinsScriptSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsViewSpec.
+func (in *JenkinsViewSpec) DeepCopy() *JenkinsViewSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(JenkinsScriptSpec)
+	out := new(JenkinsViewSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JenkinsScriptStatus) DeepCopyInto(out *JenkinsScriptStatus) {
+func (in *JenkinsViewStatus) DeepCopyInto(out *JenkinsViewStatus) {
 	*out = *in
-	in.LastTimeUpdated.DeepCopyInto(&out.LastTimeUpdated)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsScriptStatus.
-func (in *JenkinsScriptStatus) DeepCopy() *JenkinsScriptStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsViewStatus.
+func (in *JenkinsViewStatus) DeepCopy() *JenkinsViewStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(JenkinsScriptStatus)
+	out := new(JenkinsViewStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JenkinsServiceAccount) DeepCopyInto(out *JenkinsServiceAccount) {
+func (in *Job) DeepCopyInto(out *Job) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
-	in.Status.DeepCopyInto(&out.Status)
+	if in.AutoTriggerPeriod != nil {
+		in, out := &in.AutoTriggerPeriod, &out.AutoTriggerPeriod
+		*out = new(int32)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsServiceAccount.
-func (in *JenkinsServiceAccount) DeepCopy() *JenkinsServiceAccount {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Job.
+func (in *Job) DeepCopy() *Job {
 	if in == nil {
 		return nil
 	}
-	out := new(JenkinsServiceAccount)
+	out := new(Job)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *JenkinsServiceAccount) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JenkinsServiceAccountList) DeepCopyInto(out *JenkinsServiceAccountList) {
+func (in *JobProvision) DeepCopyInto(out *JobProvision) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]JenkinsServiceAccount, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsServiceAccountList.
-func (in *JenkinsServiceAccountList) DeepCopy() *JenkinsServiceAccountList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobProvision.
+func (in *JobProvision) DeepCopy() *JobProvision {
 	if in == nil {
 		return nil
 	}
-	out := new(JenkinsServiceAccountList)
+	out := new(JobProvision)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *JenkinsServiceAccountList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JenkinsServiceAccountSpec) DeepCopyInto(out *JenkinsServiceAccountSpec) {
+func (in *KeycloakRoleMapping) DeepCopyInto(out *KeycloakRoleMapping) {
 	*out = *in
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsServiceAccountSpec.
-func (in *JenkinsServiceAccountSpec) DeepCopy() *JenkinsServiceAccountSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakRoleMapping.
+func (in *KeycloakRoleMapping) DeepCopy() *KeycloakRoleMapping {
 	if in == nil {
 		return nil
 	}
-	out := new(JenkinsServiceAccountSpec)
+	out := new(KeycloakRoleMapping)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JenkinsServiceAccountStatus) DeepCopyInto(out *JenkinsServiceAccountStatus) {
+func (in *KeycloakSpec) DeepCopyInto(out *KeycloakSpec) {
 	*out = *in
-	in.LastTimeUpdated.DeepCopyInto(&out.LastTimeUpdated)
+	if in.RoleMappings != nil {
+		in, out := &in.RoleMappings, &out.RoleMappings
+		*out = make([]KeycloakRoleMapping, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsServiceAccountStatus.
-func (in *JenkinsServiceAccountStatus) DeepCopy() *JenkinsServiceAccountStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakSpec.
+func (in *KeycloakSpec) DeepCopy() *KeycloakSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(JenkinsServiceAccountStatus)
+	out := new(KeycloakSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JenkinsSharedLibraries) DeepCopyInto(out *JenkinsSharedLibraries) {
+func (in *KubernetesCloud) DeepCopyInto(out *KubernetesCloud) {
 	*out = *in
-	if in.CredentialID != nil {
-		in, out := &in.CredentialID, &out.CredentialID
-		*out = new(string)
+	if in.ContainerCap != nil {
+		in, out := &in.ContainerCap, &out.ContainerCap
+		*out = new(int32)
 		**out = **in
 	}
-	if in.Type != nil {
-		in, out := &in.Type, &out.Type
-		*out = new(string)
+	if in.ConnectTimeout != nil {
+		in, out := &in.ConnectTimeout, &out.ConnectTimeout
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ReadTimeout != nil {
+		in, out := &in.ReadTimeout, &out.ReadTimeout
+		*out = new(int32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsSharedLibraries.
-func (in *JenkinsSharedLibraries) DeepCopy() *JenkinsSharedLibraries {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesCloud.
+func (in *KubernetesCloud) DeepCopy() *KubernetesCloud {
 	if in == nil {
 		return nil
 	}
-	out := new(JenkinsSharedLibraries)
+	out := new(KubernetesCloud)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JenkinsSharedLibrary) DeepCopyInto(out *JenkinsSharedLibrary) {
+func (in *LDAPSecurityRealm) DeepCopyInto(out *LDAPSecurityRealm) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	if in.BindPasswordRef != nil {
+		in, out := &in.BindPasswordRef, &out.BindPasswordRef
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsSharedLibrary.
-func (in *JenkinsSharedLibrary) DeepCopy() *JenkinsSharedLibrary {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPSecurityRealm.
+func (in *LDAPSecurityRealm) DeepCopy() *LDAPSecurityRealm {
 	if in == nil {
 		return nil
 	}
-	out := new(JenkinsSharedLibrary)
+	out := new(LDAPSecurityRealm)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *JenkinsSharedLibrary) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OIDCClaimMappings) DeepCopyInto(out *OIDCClaimMappings) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCClaimMappings.
+func (in *OIDCClaimMappings) DeepCopy() *OIDCClaimMappings {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(OIDCClaimMappings)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JenkinsSharedLibraryList) DeepCopyInto(out *JenkinsSharedLibraryList) {
+func (in *OIDCSecurityRealm) DeepCopyInto(out *OIDCSecurityRealm) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]JenkinsSharedLibrary, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	in.ClientSecretRef.DeepCopyInto(&out.ClientSecretRef)
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
+	out.ClaimMappings = in.ClaimMappings
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsSharedLibraryList.
-func (in *JenkinsSharedLibraryList) DeepCopy() *JenkinsSharedLibraryList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCSecurityRealm.
+func (in *OIDCSecurityRealm) DeepCopy() *OIDCSecurityRealm {
 	if in == nil {
 		return nil
 	}
-	out := new(JenkinsSharedLibraryList)
+	out := new(OIDCSecurityRealm)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *JenkinsSharedLibraryList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JenkinsSharedLibrarySpec) DeepCopyInto(out *JenkinsSharedLibrarySpec) {
+func (in *OrphanedItemStrategy) DeepCopyInto(out *OrphanedItemStrategy) {
 	*out = *in
-	if in.OwnerName != nil {
-		in, out := &in.OwnerName, &out.OwnerName
-		*out = new(string)
+	if in.Prune != nil {
+		in, out := &in.Prune, &out.Prune
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DaysToKeep != nil {
+		in, out := &in.DaysToKeep, &out.DaysToKeep
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NumToKeep != nil {
+		in, out := &in.NumToKeep, &out.NumToKeep
+		*out = new(int32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsSharedLibrarySpec.
-func (in *JenkinsSharedLibrarySpec) DeepCopy() *JenkinsSharedLibrarySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrphanedItemStrategy.
+func (in *OrphanedItemStrategy) DeepCopy() *OrphanedItemStrategy {
 	if in == nil {
 		return nil
 	}
-	out := new(JenkinsSharedLibrarySpec)
+	out := new(OrphanedItemStrategy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JenkinsSharedLibraryStatus) DeepCopyInto(out *JenkinsSharedLibraryStatus) {
+func (in *PendingTokenRevocation) DeepCopyInto(out *PendingTokenRevocation) {
 	*out = *in
+	in.RevokeAfter.DeepCopyInto(&out.RevokeAfter)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsSharedLibraryStatus.
-func (in *JenkinsSharedLibraryStatus) DeepCopy() *JenkinsSharedLibraryStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingTokenRevocation.
+func (in *PendingTokenRevocation) DeepCopy() *PendingTokenRevocation {
 	if in == nil {
 		return nil
 	}
-	out := new(JenkinsSharedLibraryStatus)
+	out := new(PendingTokenRevocation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JenkinsSpec) DeepCopyInto(out *JenkinsSpec) {
+func (in *PluginManagement) DeepCopyInto(out *PluginManagement) {
 	*out = *in
-	if in.SharedLibraries != nil {
-		in, out := &in.SharedLibraries, &out.SharedLibraries
-		*out = make([]JenkinsSharedLibraries, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Plugins != nil {
+		in, out := &in.Plugins, &out.Plugins
+		*out = make([]JenkinsPlugin, len(*in))
+		copy(*out, *in)
 	}
-	out.KeycloakSpec = in.KeycloakSpec
-	out.EdpSpec = in.EdpSpec
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsSpec.
-func (in *JenkinsSpec) DeepCopy() *JenkinsSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginManagement.
+func (in *PluginManagement) DeepCopy() *PluginManagement {
 	if in == nil {
 		return nil
 	}
-	out := new(JenkinsSpec)
+	out := new(PluginManagement)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JenkinsStatus) DeepCopyInto(out *JenkinsStatus) {
+func (in *PluginStatus) DeepCopyInto(out *PluginStatus) {
 	*out = *in
-	in.LastTimeUpdated.DeepCopyInto(&out.LastTimeUpdated)
-	if in.Slaves != nil {
-		in, out := &in.Slaves, &out.Slaves
-		*out = make([]Slave, len(*in))
-		copy(*out, *in)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginStatus.
+func (in *PluginStatus) DeepCopy() *PluginStatus {
+	if in == nil {
+		return nil
 	}
-	if in.JobProvisions != nil {
-		in, out := &in.JobProvisions, &out.JobProvisions
-		*out = make([]JobProvision, len(*in))
+	out := new(PluginStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PluginsStatus) DeepCopyInto(out *PluginsStatus) {
+	*out = *in
+	if in.Plugins != nil {
+		in, out := &in.Plugins, &out.Plugins
+		*out = make([]PluginStatus, len(*in))
 		copy(*out, *in)
 	}
+	if in.LastRestartTime != nil {
+		in, out := &in.LastRestartTime, &out.LastRestartTime
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JenkinsStatus.
-func (in *JenkinsStatus) DeepCopy() *JenkinsStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginsStatus.
+func (in *PluginsStatus) DeepCopy() *PluginsStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(JenkinsStatus)
+	out := new(PluginsStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Job) DeepCopyInto(out *Job) {
+func (in *ReplacedAPIToken) DeepCopyInto(out *ReplacedAPIToken) {
 	*out = *in
-	if in.AutoTriggerPeriod != nil {
-		in, out := &in.AutoTriggerPeriod, &out.AutoTriggerPeriod
-		*out = new(int32)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplacedAPIToken.
+func (in *ReplacedAPIToken) DeepCopy() *ReplacedAPIToken {
+	if in == nil {
+		return nil
 	}
+	out := new(ReplacedAPIToken)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Job.
-func (in *Job) DeepCopy() *Job {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleAssignment) DeepCopyInto(out *RoleAssignment) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleAssignment.
+func (in *RoleAssignment) DeepCopy() *RoleAssignment {
 	if in == nil {
 		return nil
 	}
-	out := new(Job)
+	out := new(RoleAssignment)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JobProvision) DeepCopyInto(out *JobProvision) {
+func (in *RoleAssignmentResult) DeepCopyInto(out *RoleAssignmentResult) {
 	*out = *in
+	out.RoleAssignment = in.RoleAssignment
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobProvision.
-func (in *JobProvision) DeepCopy() *JobProvision {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleAssignmentResult.
+func (in *RoleAssignmentResult) DeepCopy() *RoleAssignmentResult {
 	if in == nil {
 		return nil
 	}
-	out := new(JobProvision)
+	out := new(RoleAssignmentResult)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KeycloakSpec) DeepCopyInto(out *KeycloakSpec) {
+func (in *SecurityRealm) DeepCopyInto(out *SecurityRealm) {
 	*out = *in
+	if in.OIDC != nil {
+		in, out := &in.OIDC, &out.OIDC
+		*out = new(OIDCSecurityRealm)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LDAP != nil {
+		in, out := &in.LDAP, &out.LDAP
+		*out = new(LDAPSecurityRealm)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeycloakSpec.
-func (in *KeycloakSpec) DeepCopy() *KeycloakSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityRealm.
+func (in *SecurityRealm) DeepCopy() *SecurityRealm {
 	if in == nil {
 		return nil
 	}
-	out := new(KeycloakSpec)
+	out := new(SecurityRealm)
 	in.DeepCopyInto(out)
 	return out
 }
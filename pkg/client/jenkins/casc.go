@@ -0,0 +1,65 @@
+package jenkins
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const cascContentType = "application/x-yaml"
+
+type cascIssue struct {
+	Line    int    `json:"line"`
+	Warning string `json:"warning"`
+}
+
+// CheckConfigurationAsCode validates the Configuration as Code YAML without applying it.
+// An invalid configuration is returned as an error, the returned warnings do not prevent applying it.
+func (jc JenkinsClient) CheckConfigurationAsCode(config string) ([]string, error) {
+	headers, err := jc.crumbHeaders()
+	if err != nil {
+		return nil, err
+	}
+
+	headers["Content-Type"] = cascContentType
+
+	rsp, err := jc.resty.R().
+		SetHeaders(headers).
+		SetBody(config).
+		Post("/configuration-as-code/check")
+	if err = parseRestyResponse(rsp, err); err != nil {
+		return nil, fmt.Errorf("configuration as code is invalid: %w", err)
+	}
+
+	var issues []cascIssue
+
+	if err = json.Unmarshal(rsp.Body(), &issues); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal configuration as code issues: %w", err)
+	}
+
+	warnings := make([]string, 0, len(issues))
+	for _, i := range issues {
+		warnings = append(warnings, fmt.Sprintf("line %d: %s", i.Line, i.Warning))
+	}
+
+	return warnings, nil
+}
+
+// ApplyConfigurationAsCode applies the Configuration as Code YAML to Jenkins.
+func (jc JenkinsClient) ApplyConfigurationAsCode(config string) error {
+	headers, err := jc.crumbHeaders()
+	if err != nil {
+		return err
+	}
+
+	headers["Content-Type"] = cascContentType
+
+	rsp, err := jc.resty.R().
+		SetHeaders(headers).
+		SetBody(config).
+		Post("/configuration-as-code/apply")
+	if err = parseRestyResponse(rsp, err); err != nil {
+		return fmt.Errorf("failed to apply configuration as code: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package jenkins
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+)
+
+const testCasCConfig = "jenkins:\n  systemMessage: managed\n"
+
+func TestJenkinsClient_CheckConfigurationAsCode(t *testing.T) {
+	jc := newPluginManagerClient()
+
+	httpmock.RegisterResponder(http.MethodPost, "/configuration-as-code/check",
+		func(req *http.Request) (*http.Response, error) {
+			body, err := io.ReadAll(req.Body)
+			if err != nil {
+				return nil, err
+			}
+
+			if string(body) != testCasCConfig || req.Header.Get(jenkinsCrumbKey) != "cr" {
+				return httpmock.NewStringResponse(http.StatusBadRequest, ""), nil
+			}
+
+			return httpmock.NewStringResponse(http.StatusOK, `[{"line":2,"warning":"'systemMessage' is deprecated"}]`), nil
+		})
+
+	warnings, err := jc.CheckConfigurationAsCode(testCasCConfig)
+	require.NoError(t, err)
+	require.Equal(t, []string{"line 2: 'systemMessage' is deprecated"}, warnings)
+}
+
+func TestJenkinsClient_CheckConfigurationAsCode_Invalid(t *testing.T) {
+	jc := newPluginManagerClient()
+
+	httpmock.RegisterResponder(http.MethodPost, "/configuration-as-code/check",
+		httpmock.NewStringResponder(http.StatusInternalServerError, "Invalid configuration elements for type"))
+
+	_, err := jc.CheckConfigurationAsCode(testCasCConfig)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "configuration as code is invalid")
+}
+
+func TestJenkinsClient_ApplyConfigurationAsCode(t *testing.T) {
+	jc := newPluginManagerClient()
+
+	httpmock.RegisterResponder(http.MethodPost, "/configuration-as-code/apply",
+		httpmock.NewStringResponder(http.StatusOK, ""))
+
+	require.NoError(t, jc.ApplyConfigurationAsCode(testCasCConfig))
+}
@@ -0,0 +1,93 @@
+package jenkins
+
+const kubernetesCloudsScript = "kubernetes-clouds"
+
+// KubernetesCloud is the Kubernetes plugin cloud, all settings are applied as given.
+type KubernetesCloud struct {
+	Name           string `json:"name"`
+	ServerURL      string `json:"serverURL"`
+	Namespace      string `json:"namespace"`
+	CredentialsID  string `json:"credentialsID"`
+	SkipTLSVerify  bool   `json:"skipTLSVerify"`
+	JenkinsURL     string `json:"jenkinsURL"`
+	JenkinsTunnel  string `json:"jenkinsTunnel"`
+	WebSocket      bool   `json:"webSocket"`
+	ContainerCap   int32  `json:"containerCap"`
+	PodRetention   string `json:"podRetention"`
+	ConnectTimeout int32  `json:"connectTimeout"`
+	ReadTimeout    int32  `json:"readTimeout"`
+}
+
+// PodTemplate is the pod template loaded into a Kubernetes cloud.
+type PodTemplate struct {
+	Cloud string `json:"cloud"`
+	Name  string `json:"name"`
+	Label string `json:"label"`
+}
+
+// cloudManager manages Kubernetes clouds with the kubernetes-clouds tech script run in the script console.
+type cloudManager struct {
+	jc JenkinsClient
+	// script is the content of the tech script, it is read from the tech scripts directory if empty.
+	script string
+}
+
+type cloudRequest struct {
+	Operation string           `json:"operation"`
+	Cloud     *KubernetesCloud `json:"cloud,omitempty"`
+	Name      string           `json:"name,omitempty"`
+}
+
+type cloudResponse struct {
+	Changed   bool          `json:"changed"`
+	Templates []PodTemplate `json:"templates"`
+}
+
+func (m cloudManager) SaveKubernetesCloud(cloud *KubernetesCloud) (bool, error) {
+	rsp, err := m.run(&cloudRequest{Operation: "saveCloud", Cloud: cloud})
+	if err != nil {
+		return false, err
+	}
+
+	return rsp.Changed, nil
+}
+
+func (m cloudManager) DeleteKubernetesCloud(name string) error {
+	_, err := m.run(&cloudRequest{Operation: "deleteCloud", Name: name})
+
+	return err
+}
+
+func (m cloudManager) GetPodTemplates() ([]PodTemplate, error) {
+	rsp, err := m.run(&cloudRequest{Operation: "listTemplates"})
+	if err != nil {
+		return nil, err
+	}
+
+	return rsp.Templates, nil
+}
+
+func (m cloudManager) run(req *cloudRequest) (*cloudResponse, error) {
+	var rsp cloudResponse
+
+	if err := m.jc.runTechScriptRequest(kubernetesCloudsScript, m.script, req.Operation, req, &rsp); err != nil {
+		return nil, err
+	}
+
+	return &rsp, nil
+}
+
+// SaveKubernetesCloud creates the cloud or updates its settings, it returns true if the cloud has been changed.
+func (jc JenkinsClient) SaveKubernetesCloud(cloud *KubernetesCloud) (bool, error) {
+	return cloudManager{jc: jc}.SaveKubernetesCloud(cloud)
+}
+
+// DeleteKubernetesCloud deletes the cloud, it does nothing if there is no such cloud.
+func (jc JenkinsClient) DeleteKubernetesCloud(name string) error {
+	return cloudManager{jc: jc}.DeleteKubernetesCloud(name)
+}
+
+// GetPodTemplates returns the pod templates loaded into all Kubernetes clouds.
+func (jc JenkinsClient) GetPodTemplates() ([]PodTemplate, error) {
+	return cloudManager{jc: jc}.GetPodTemplates()
+}
@@ -0,0 +1,86 @@
+package jenkins
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newCloudManager returns the cloud manager with the script console responding with respond.
+func newCloudManager(t *testing.T, respond func(req *cloudRequest) string) cloudManager {
+	t.Helper()
+
+	jc := newScriptConsoleClient(t, func(data []byte) string {
+		var req cloudRequest
+		require.NoError(t, json.Unmarshal(data, &req))
+
+		return respond(&req)
+	})
+
+	return cloudManager{
+		jc:     jc,
+		script: "println('test')",
+	}
+}
+
+func TestCloudManager_SaveKubernetesCloud(t *testing.T) {
+	cloud := &KubernetesCloud{
+		Name:           "openshift",
+		Namespace:      "ns",
+		JenkinsURL:     "http://jenkins:8080",
+		WebSocket:      true,
+		ContainerCap:   50,
+		PodRetention:   "Never",
+		ConnectTimeout: 5,
+		ReadTimeout:    15,
+	}
+
+	var got *cloudRequest
+
+	m := newCloudManager(t, func(req *cloudRequest) string {
+		got = req
+
+		return `{"changed":true}`
+	})
+
+	changed, err := m.SaveKubernetesCloud(cloud)
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.Equal(t, &cloudRequest{Operation: "saveCloud", Cloud: cloud}, got)
+}
+
+func TestCloudManager_DeleteKubernetesCloud(t *testing.T) {
+	m := newCloudManager(t, func(req *cloudRequest) string {
+		if req.Operation != "deleteCloud" || req.Name != "old" {
+			return `{"error":"unexpected request"}`
+		}
+
+		return `{}`
+	})
+
+	require.NoError(t, m.DeleteKubernetesCloud("old"))
+
+	m = newCloudManager(t, func(req *cloudRequest) string {
+		return `{"error":"cloud old is not a Kubernetes cloud"}`
+	})
+
+	require.EqualError(t, m.DeleteKubernetesCloud("old"), "cloud old is not a Kubernetes cloud")
+}
+
+func TestCloudManager_GetPodTemplates(t *testing.T) {
+	m := newCloudManager(t, func(req *cloudRequest) string {
+		if req.Operation != "listTemplates" {
+			return `{"error":"unexpected request"}`
+		}
+
+		return `{"templates":[{"cloud":"openshift","name":"go","label":"go"},{"cloud":"remote","name":"maven","label":"maven java"}]}`
+	})
+
+	templates, err := m.GetPodTemplates()
+	require.NoError(t, err)
+	require.Equal(t, []PodTemplate{
+		{Cloud: "openshift", Name: "go", Label: "go"},
+		{Cloud: "remote", Name: "maven", Label: "maven java"},
+	}, templates)
+}
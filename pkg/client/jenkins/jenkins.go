@@ -100,6 +100,7 @@ func InitGoJenkinsClient(instance *jenkinsApi.Jenkins, platformService platform.
 	log.Info("Jenkins client is initialized", "url", url)
 
 	return &JenkinsClient{
+		instance:        instance,
 		GoJenkins:       jenkins,
 		PlatformService: platformService,
 		resty:           resty.SetHostURL(url).SetBasicAuth(string(s[usernameKey]), string(s["password"])),
@@ -432,18 +433,69 @@ func (jc JenkinsClient) GetJobByName(jobName string) (*gojenkins.Job, error) {
 	return job, nil
 }
 
-func (jc JenkinsClient) TriggerJob(job string, parameters map[string]string) error {
+// TriggerJob triggers the job and returns ID of the created queue item.
+func (jc JenkinsClient) TriggerJob(job string, parameters map[string]string) (int64, error) {
 	vLog := log.WithValues(logNameKey, job)
 
 	vLog.Info("triggering jenkins job")
 
-	if _, err := jc.GoJenkins.BuildJob(job, parameters); err != nil {
-		return fmt.Errorf("failed to BuildJob: %w", err)
+	queueID, err := jc.GoJenkins.BuildJob(job, parameters)
+	if err != nil {
+		return 0, fmt.Errorf("failed to BuildJob: %w", err)
 	}
 
-	vLog.Info("jenkins job has been triggered")
+	vLog.Info("jenkins job has been triggered", "queueItem", queueID)
 
-	return nil
+	return queueID, nil
+}
+
+var (
+	// ErrQueueItemCancelled is returned for the queue item which has been cancelled before its build was started.
+	ErrQueueItemCancelled = errors.New("queue item has been cancelled")
+	// ErrQueueItemNotFound is returned for the queue item which Jenkins no longer keeps, e.g. it has expired.
+	ErrQueueItemNotFound = errors.New("queue item is not found")
+)
+
+type queueItem struct {
+	Cancelled  bool `json:"cancelled"`
+	Executable struct {
+		Number int64 `json:"number"`
+	} `json:"executable"`
+}
+
+// GetQueuedBuildNumber returns number of the build started from the queue item.
+// Zero is returned if the build has not been started yet. ErrQueueItemCancelled or ErrQueueItemNotFound
+// is returned if the build will never be started from the queue item.
+func (jc JenkinsClient) GetQueuedBuildNumber(queueID int64) (int64, error) {
+	var item queueItem
+
+	rsp, err := jc.resty.R().Get(fmt.Sprintf("/queue/item/%d/api/json", queueID))
+	if err == nil && rsp.StatusCode() == http.StatusNotFound {
+		return 0, fmt.Errorf("failed to get queue item %d: %w", queueID, ErrQueueItemNotFound)
+	}
+
+	if err = parseRestyResponse(rsp, err); err != nil {
+		return 0, fmt.Errorf("failed to get queue item %d: %w", queueID, err)
+	}
+
+	if err = json.Unmarshal(rsp.Body(), &item); err != nil {
+		return 0, fmt.Errorf("failed to unmarshal queue item %d: %w", queueID, err)
+	}
+
+	if item.Cancelled {
+		return 0, fmt.Errorf("failed to get build of queue item %d: %w", queueID, ErrQueueItemCancelled)
+	}
+
+	return item.Executable.Number, nil
+}
+
+func (jc JenkinsClient) GetBuild(job string, number int64) (*gojenkins.Build, error) {
+	build, err := jc.GoJenkins.GetBuild(job, number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get build %d of job %s: %w", number, job, err)
+	}
+
+	return build, nil
 }
 
 func (JenkinsClient) GetLastBuild(job *gojenkins.Job) (*gojenkins.Build, error) {
@@ -16,11 +16,40 @@ type ClientInterface interface {
 	BuildJob(jobName string, parameters map[string]string) (*int64, error)
 	GetLastBuild(job *gojenkins.Job) (*gojenkins.Build, error)
 	BuildIsRunning(build *gojenkins.Build) bool
+	TriggerJob(job string, parameters map[string]string) (int64, error)
+	GetQueuedBuildNumber(queueID int64) (int64, error)
+	GetBuild(job string, number int64) (*gojenkins.Build, error)
 	AddRole(roleType, name, pattern string, permissions []string) error
+	UpdateRole(roleType, name, pattern string, permissions []string) error
 	RemoveRoles(roleType string, roleNames []string) error
 	AssignRole(roleType, roleName, subject string) error
 	GetRole(roleType, roleName string) (*Role, error)
 	UnAssignRole(roleType, roleName, subject string) error
+	GetPermissionIDs() ([]string, error)
+	GetUser(id string) (*User, error)
+	SaveUser(id, password, fullName, email string) error
+	DeleteUser(id string) error
+	CreateAPIToken(userID, tokenName string) (*APIToken, error)
+	ListAPITokens(userID string) ([]APIToken, error)
+	RevokeAPIToken(userID, tokenUUID string) error
+	GetPlugins() ([]Plugin, error)
+	InstallPlugins(plugins []string) error
+	DeployPlugin(pluginURL string) error
+	UninstallPlugin(name string) error
+	IsRestartRequired() (bool, error)
+	SafeRestart() error
+	CheckConfigurationAsCode(config string) ([]string, error)
+	ApplyConfigurationAsCode(config string) error
+	SaveKubernetesCloud(cloud *KubernetesCloud) (bool, error)
+	DeleteKubernetesCloud(name string) error
+	GetPodTemplates() ([]PodTemplate, error)
+	SaveAgentNode(node *AgentNode) (string, error)
+	DeleteAgentNode(name string) error
+	SaveListView(folder string, view *ListView) error
+	DeleteListView(folder, name string) error
+	SaveJobConfig(folder, name string, config []byte) (bool, error)
+	DeleteJob(folder, name string) error
+	ScheduleScan(folder, name string) error
 }
 
 type ClientFactory interface {
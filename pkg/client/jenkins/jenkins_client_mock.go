@@ -41,10 +41,35 @@ func (j *ClientMock) BuildIsRunning(build *gojenkins.Build) bool {
 	return j.Called(build).Bool(0)
 }
 
+func (j *ClientMock) TriggerJob(job string, parameters map[string]string) (int64, error) {
+	called := j.Called(job, parameters)
+
+	return called.Get(0).(int64), called.Error(1)
+}
+
+func (j *ClientMock) GetQueuedBuildNumber(queueID int64) (int64, error) {
+	called := j.Called(queueID)
+
+	return called.Get(0).(int64), called.Error(1)
+}
+
+func (j *ClientMock) GetBuild(job string, number int64) (*gojenkins.Build, error) {
+	called := j.Called(job, number)
+	if err := called.Error(1); err != nil {
+		return nil, err
+	}
+
+	return called.Get(0).(*gojenkins.Build), nil
+}
+
 func (j *ClientMock) AddRole(roleType, name, pattern string, permissions []string) error {
 	return j.Called(roleType, name, pattern, permissions).Error(0)
 }
 
+func (j *ClientMock) UpdateRole(roleType, name, pattern string, permissions []string) error {
+	return j.Called(roleType, name, pattern, permissions).Error(0)
+}
+
 func (j *ClientMock) RemoveRoles(roleType string, roleNames []string) error {
 	return j.Called(roleType, roleNames).Error(0)
 }
@@ -62,10 +87,153 @@ func (j *ClientMock) GetRole(roleType, roleName string) (*Role, error) {
 	return called.Get(0).(*Role), nil
 }
 
+func (j *ClientMock) GetPermissionIDs() ([]string, error) {
+	called := j.Called()
+	if err := called.Error(1); err != nil {
+		return nil, err
+	}
+
+	return called.Get(0).([]string), nil
+}
+
 func (j *ClientMock) UnAssignRole(roleType, roleName, subject string) error {
 	return j.Called(roleType, roleName, subject).Error(0)
 }
 
+func (j *ClientMock) GetUser(id string) (*User, error) {
+	called := j.Called(id)
+	if err := called.Error(1); err != nil {
+		return nil, err
+	}
+
+	return called.Get(0).(*User), nil
+}
+
+func (j *ClientMock) SaveUser(id, password, fullName, email string) error {
+	return j.Called(id, password, fullName, email).Error(0)
+}
+
+func (j *ClientMock) DeleteUser(id string) error {
+	return j.Called(id).Error(0)
+}
+
+func (j *ClientMock) CreateAPIToken(userID, tokenName string) (*APIToken, error) {
+	called := j.Called(userID, tokenName)
+	if err := called.Error(1); err != nil {
+		return nil, err
+	}
+
+	return called.Get(0).(*APIToken), nil
+}
+
+func (j *ClientMock) ListAPITokens(userID string) ([]APIToken, error) {
+	called := j.Called(userID)
+	if err := called.Error(1); err != nil {
+		return nil, err
+	}
+
+	return called.Get(0).([]APIToken), nil
+}
+
+func (j *ClientMock) RevokeAPIToken(userID, tokenUUID string) error {
+	return j.Called(userID, tokenUUID).Error(0)
+}
+
+func (j *ClientMock) GetPlugins() ([]Plugin, error) {
+	called := j.Called()
+	if err := called.Error(1); err != nil {
+		return nil, err
+	}
+
+	return called.Get(0).([]Plugin), nil
+}
+
+func (j *ClientMock) InstallPlugins(plugins []string) error {
+	return j.Called(plugins).Error(0)
+}
+
+func (j *ClientMock) DeployPlugin(pluginURL string) error {
+	return j.Called(pluginURL).Error(0)
+}
+
+func (j *ClientMock) UninstallPlugin(name string) error {
+	return j.Called(name).Error(0)
+}
+
+func (j *ClientMock) IsRestartRequired() (bool, error) {
+	called := j.Called()
+
+	return called.Bool(0), called.Error(1)
+}
+
+func (j *ClientMock) SafeRestart() error {
+	return j.Called().Error(0)
+}
+
+func (j *ClientMock) CheckConfigurationAsCode(config string) ([]string, error) {
+	called := j.Called(config)
+	if err := called.Error(1); err != nil {
+		return nil, err
+	}
+
+	return called.Get(0).([]string), nil
+}
+
+func (j *ClientMock) ApplyConfigurationAsCode(config string) error {
+	return j.Called(config).Error(0)
+}
+
+func (j *ClientMock) SaveKubernetesCloud(cloud *KubernetesCloud) (bool, error) {
+	called := j.Called(cloud)
+
+	return called.Bool(0), called.Error(1)
+}
+
+func (j *ClientMock) DeleteKubernetesCloud(name string) error {
+	return j.Called(name).Error(0)
+}
+
+func (j *ClientMock) GetPodTemplates() ([]PodTemplate, error) {
+	called := j.Called()
+	if err := called.Error(1); err != nil {
+		return nil, err
+	}
+
+	return called.Get(0).([]PodTemplate), nil
+}
+
+func (j *ClientMock) SaveAgentNode(node *AgentNode) (string, error) {
+	called := j.Called(node)
+
+	return called.String(0), called.Error(1)
+}
+
+func (j *ClientMock) DeleteAgentNode(name string) error {
+	return j.Called(name).Error(0)
+}
+
+func (j *ClientMock) SaveListView(folder string, view *ListView) error {
+	return j.Called(folder, view).Error(0)
+}
+
+func (j *ClientMock) DeleteListView(folder, name string) error {
+	return j.Called(folder, name).Error(0)
+}
+
+func (j *ClientMock) SaveJobConfig(folder, name string, config []byte) (bool, error) {
+	called := j.Called(folder, name, config)
+
+	return called.Bool(0), called.Error(1)
+}
+
+func (j *ClientMock) DeleteJob(folder, name string) error {
+	return j.Called(folder, name).Error(0)
+}
+
+func (j *ClientMock) ScheduleScan(folder, name string) error {
+	return j.Called(folder, name).Error(0)
+}
+
 type ClientBuilderMock struct {
 	mock.Mock
 }
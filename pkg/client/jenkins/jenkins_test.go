@@ -680,7 +680,45 @@ func TestJenkinsClient_TriggerJob_Err(t *testing.T) {
 		GoJenkins: jenkins,
 	}
 
-	assert.Error(t, jc.TriggerJob(name, params))
+	_, err = jc.TriggerJob(name, params)
+	assert.Error(t, err)
+}
+
+func TestJenkinsClient_GetQueuedBuildNumber(t *testing.T) {
+	jc := JenkinsClient{resty: CreateMockResty()}
+
+	httpmock.RegisterResponder(
+		http.MethodGet,
+		"/queue/item/5/api/json",
+		httpmock.NewStringResponder(http.StatusOK, `{"id":5,"executable":{"number":7}}`))
+
+	n, err := jc.GetQueuedBuildNumber(5)
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), n)
+}
+
+func TestJenkinsClient_GetQueuedBuildNumber_Cancelled(t *testing.T) {
+	jc := JenkinsClient{resty: CreateMockResty()}
+
+	httpmock.RegisterResponder(
+		http.MethodGet,
+		"/queue/item/5/api/json",
+		httpmock.NewStringResponder(http.StatusOK, `{"id":5,"cancelled":true,"executable":null}`))
+
+	_, err := jc.GetQueuedBuildNumber(5)
+	require.ErrorIs(t, err, ErrQueueItemCancelled)
+}
+
+func TestJenkinsClient_GetQueuedBuildNumber_NotFound(t *testing.T) {
+	jc := JenkinsClient{resty: CreateMockResty()}
+
+	httpmock.RegisterResponder(
+		http.MethodGet,
+		"/queue/item/5/api/json",
+		httpmock.NewStringResponder(http.StatusNotFound, "not found"))
+
+	_, err := jc.GetQueuedBuildNumber(5)
+	require.ErrorIs(t, err, ErrQueueItemNotFound)
 }
 
 func TestInitGoJenkinsClient(t *testing.T) {
@@ -0,0 +1,101 @@
+package jenkins
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const jobContentType = "application/xml"
+
+// SaveJobConfig creates the job in the folder from its config.xml or replaces the configuration of the existing job.
+// The job is created in the Jenkins root if the folder is empty. It returns true if the job has been created.
+func (jc JenkinsClient) SaveJobConfig(folder, name string, config []byte) (bool, error) {
+	exists, err := jc.jobExists(folder, name)
+	if err != nil {
+		return false, err
+	}
+
+	headers, err := jc.crumbHeaders()
+	if err != nil {
+		return false, err
+	}
+
+	headers["Content-Type"] = jobContentType
+
+	req := jc.resty.R().
+		SetHeaders(headers).
+		SetBody(config)
+
+	path := fmt.Sprintf("%s/config.xml", jobPath(folder, name))
+	if !exists {
+		req.SetQueryParam("name", name)
+		path = fmt.Sprintf("%s/createItem", folderPath(folder))
+	}
+
+	rsp, err := req.Post(path)
+	if err = parseRestyResponse(rsp, err); err != nil {
+		return false, fmt.Errorf("failed to save job %s: %w", name, err)
+	}
+
+	return !exists, nil
+}
+
+// DeleteJob deletes the job from the folder, it does nothing if there is no such job.
+func (jc JenkinsClient) DeleteJob(folder, name string) error {
+	headers, err := jc.crumbHeaders()
+	if err != nil {
+		return err
+	}
+
+	rsp, err := jc.resty.R().
+		SetHeaders(headers).
+		Post(fmt.Sprintf("%s/doDelete", jobPath(folder, name)))
+	if err == nil && rsp.StatusCode() == http.StatusNotFound {
+		return nil
+	}
+
+	if err = parseRestyResponse(rsp, err); err != nil {
+		return fmt.Errorf("failed to delete job %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// ScheduleScan schedules the branch indexing of the multibranch pipeline or the scan of the organization folder.
+func (jc JenkinsClient) ScheduleScan(folder, name string) error {
+	headers, err := jc.crumbHeaders()
+	if err != nil {
+		return err
+	}
+
+	rsp, err := jc.resty.R().
+		SetHeaders(headers).
+		SetQueryParam("delay", "0").
+		Post(fmt.Sprintf("%s/build", jobPath(folder, name)))
+	if err = parseRestyResponse(rsp, err); err != nil {
+		return fmt.Errorf("failed to schedule scan of job %s: %w", name, err)
+	}
+
+	return nil
+}
+
+func (jc JenkinsClient) jobExists(folder, name string) (bool, error) {
+	rsp, err := jc.resty.R().
+		SetQueryParam("tree", "name").
+		Get(fmt.Sprintf("%s/api/json", jobPath(folder, name)))
+	if err == nil && rsp.StatusCode() == http.StatusNotFound {
+		return false, nil
+	}
+
+	if err = parseRestyResponse(rsp, err); err != nil {
+		return false, fmt.Errorf("failed to get job %s: %w", name, err)
+	}
+
+	return true, nil
+}
+
+// jobPath returns the URL path of the job in the folder.
+func jobPath(folder, name string) string {
+	return fmt.Sprintf("%s/job/%s", folderPath(folder), url.PathEscape(name))
+}
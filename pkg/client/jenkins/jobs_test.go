@@ -0,0 +1,90 @@
+package jenkins
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+)
+
+const testJobConfig = `<org.jenkinsci.plugins.workflow.multibranch.WorkflowMultiBranchProject/>`
+
+func TestJenkinsClient_SaveJobConfig_Create(t *testing.T) {
+	jc := newPluginManagerClient()
+
+	httpmock.RegisterResponder(http.MethodGet, "/job/team/job/app/api/json",
+		httpmock.NewStringResponder(http.StatusNotFound, ""))
+	httpmock.RegisterResponder(http.MethodPost, "/job/team/createItem",
+		func(req *http.Request) (*http.Response, error) {
+			body, err := io.ReadAll(req.Body)
+			if err != nil {
+				return nil, err
+			}
+
+			if req.Header.Get(jenkinsCrumbKey) != "cr" || req.URL.Query().Get("name") != "app" ||
+				req.Header.Get("Content-Type") != jobContentType || string(body) != testJobConfig {
+				return httpmock.NewStringResponse(http.StatusBadRequest, ""), nil
+			}
+
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	created, err := jc.SaveJobConfig("team", "app", []byte(testJobConfig))
+	require.NoError(t, err)
+	require.True(t, created)
+}
+
+func TestJenkinsClient_SaveJobConfig_Update(t *testing.T) {
+	jc := newPluginManagerClient()
+
+	httpmock.RegisterResponder(http.MethodGet, "/job/app/api/json",
+		httpmock.NewStringResponder(http.StatusOK, `{"name":"app"}`))
+	httpmock.RegisterResponder(http.MethodPost, "/job/app/config.xml",
+		httpmock.NewStringResponder(http.StatusOK, ""))
+
+	created, err := jc.SaveJobConfig("", "app", []byte(testJobConfig))
+	require.NoError(t, err)
+	require.False(t, created)
+}
+
+func TestJenkinsClient_SaveJobConfig_Err(t *testing.T) {
+	jc := newPluginManagerClient()
+
+	httpmock.RegisterResponder(http.MethodGet, "/job/app/api/json",
+		httpmock.NewStringResponder(http.StatusOK, `{"name":"app"}`))
+	httpmock.RegisterResponder(http.MethodPost, "/job/app/config.xml",
+		httpmock.NewStringResponder(http.StatusInternalServerError, "broken config"))
+
+	_, err := jc.SaveJobConfig("", "app", []byte(testJobConfig))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to save job app")
+}
+
+func TestJenkinsClient_DeleteJob(t *testing.T) {
+	jc := newPluginManagerClient()
+
+	httpmock.RegisterResponder(http.MethodPost, "/job/team/job/app/doDelete",
+		httpmock.NewStringResponder(http.StatusOK, ""))
+	httpmock.RegisterResponder(http.MethodPost, "/job/gone/doDelete",
+		httpmock.NewStringResponder(http.StatusNotFound, ""))
+
+	require.NoError(t, jc.DeleteJob("team", "app"))
+	require.NoError(t, jc.DeleteJob("", "gone"))
+}
+
+func TestJenkinsClient_ScheduleScan(t *testing.T) {
+	jc := newPluginManagerClient()
+
+	httpmock.RegisterResponder(http.MethodPost, "/job/team/job/app/build",
+		func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get(jenkinsCrumbKey) != "cr" || req.URL.Query().Get("delay") != "0" {
+				return httpmock.NewStringResponse(http.StatusBadRequest, ""), nil
+			}
+
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	require.NoError(t, jc.ScheduleScan("team", "app"))
+}
@@ -0,0 +1,84 @@
+package jenkins
+
+const matrixAuthorizationScript = "matrix-authorization"
+
+// matrixBackend emulates roles on top of the Matrix Authorization Strategy plugin.
+// Roles are managed by the matrix-authorization tech script run in the Jenkins script console.
+// Every request also grants the project roles on the jobs and folders created after the roles have been synced.
+type matrixBackend struct {
+	jc JenkinsClient
+	// script is the content of the tech script, it is read from the tech scripts directory if empty.
+	script string
+}
+
+type matrixRequest struct {
+	Operation   string   `json:"operation"`
+	Type        string   `json:"type"`
+	Name        string   `json:"name,omitempty"`
+	Names       []string `json:"names,omitempty"`
+	Pattern     string   `json:"pattern,omitempty"`
+	Permissions []string `json:"permissions,omitempty"`
+	SID         string   `json:"sid,omitempty"`
+	Overwrite   bool     `json:"overwrite,omitempty"`
+}
+
+type matrixResponse struct {
+	Role *Role `json:"role,omitempty"`
+}
+
+func (b matrixBackend) AddRole(roleType, name, pattern string, permissions []string) error {
+	_, err := b.run(&matrixRequest{
+		Operation: "addRole", Type: roleType, Name: name, Pattern: pattern, Permissions: permissions,
+	})
+
+	return err
+}
+
+func (b matrixBackend) UpdateRole(roleType, name, pattern string, permissions []string) error {
+	_, err := b.run(&matrixRequest{
+		Operation: "addRole", Type: roleType, Name: name, Pattern: pattern, Permissions: permissions, Overwrite: true,
+	})
+
+	return err
+}
+
+func (b matrixBackend) RemoveRoles(roleType string, roleNames []string) error {
+	_, err := b.run(&matrixRequest{Operation: "removeRoles", Type: roleType, Names: roleNames})
+
+	return err
+}
+
+func (b matrixBackend) AssignRole(roleType, roleName, subject string) error {
+	_, err := b.run(&matrixRequest{Operation: "assignRole", Type: roleType, Name: roleName, SID: subject})
+
+	return err
+}
+
+func (b matrixBackend) UnAssignRole(roleType, roleName, subject string) error {
+	_, err := b.run(&matrixRequest{Operation: "unassignRole", Type: roleType, Name: roleName, SID: subject})
+
+	return err
+}
+
+func (b matrixBackend) GetRole(roleType, roleName string) (*Role, error) {
+	rsp, err := b.run(&matrixRequest{Operation: "getRole", Type: roleType, Name: roleName})
+	if err != nil {
+		return nil, err
+	}
+
+	if rsp.Role == nil {
+		return nil, ErrNotFound
+	}
+
+	return rsp.Role, nil
+}
+
+func (b matrixBackend) run(req *matrixRequest) (*matrixResponse, error) {
+	var rsp matrixResponse
+
+	if err := b.jc.runTechScriptRequest(matrixAuthorizationScript, b.script, req.Operation, req, &rsp); err != nil {
+		return nil, err
+	}
+
+	return &rsp, nil
+}
@@ -0,0 +1,126 @@
+package jenkins
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/resty.v1"
+
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+)
+
+var techScriptRequestRegexp = regexp.MustCompile(`'([A-Za-z0-9+/=]+)'\.decodeBase64\(\)`)
+
+// newScriptConsoleClient returns the client with the script console responding to tech script requests with respond.
+func newScriptConsoleClient(t *testing.T, respond func(request []byte) string) JenkinsClient {
+	t.Helper()
+
+	restyClient := resty.New()
+	httpmock.ActivateNonDefault(restyClient.GetClient())
+
+	httpmock.RegisterResponder(http.MethodGet, "/crumbIssuer/api/json",
+		httpmock.NewStringResponder(http.StatusNotFound, ""))
+	httpmock.RegisterResponder(http.MethodPost, "/scriptText",
+		func(r *http.Request) (*http.Response, error) {
+			if err := r.ParseForm(); err != nil {
+				return nil, err
+			}
+
+			m := techScriptRequestRegexp.FindStringSubmatch(r.PostForm.Get("script"))
+			require.Len(t, m, 2)
+
+			data, err := base64.StdEncoding.DecodeString(m[1])
+			require.NoError(t, err)
+
+			return httpmock.NewStringResponse(http.StatusOK, respond(data)+"\n"), nil
+		})
+
+	return JenkinsClient{resty: restyClient}
+}
+
+// newMatrixBackend returns the backend with the script console responding with respond.
+func newMatrixBackend(t *testing.T, respond func(req *matrixRequest) string) matrixBackend {
+	t.Helper()
+
+	jc := newScriptConsoleClient(t, func(data []byte) string {
+		var req matrixRequest
+		require.NoError(t, json.Unmarshal(data, &req))
+
+		return respond(&req)
+	})
+
+	return matrixBackend{
+		jc:     jc,
+		script: "println('test')",
+	}
+}
+
+func TestMatrixBackend_GetRole(t *testing.T) {
+	b := newMatrixBackend(t, func(req *matrixRequest) string {
+		if req.Operation != "getRole" || req.Name != "developer" {
+			return `{}`
+		}
+
+		return `{"role":{"permissionIds":{"hudson.model.Item.Read":true},"pattern":".*","sids":["devs"]}}`
+	})
+
+	role, err := b.GetRole("projectRoles", "developer")
+	require.NoError(t, err)
+	require.Equal(t, []string{"hudson.model.Item.Read"}, role.GrantedPermissions())
+	require.Equal(t, []string{"devs"}, role.SIDs)
+
+	_, err = b.GetRole("projectRoles", "unknown")
+	require.True(t, IsErrNotFound(err))
+}
+
+func TestMatrixBackend_UpdateRole(t *testing.T) {
+	var got *matrixRequest
+
+	b := newMatrixBackend(t, func(req *matrixRequest) string {
+		got = req
+
+		return `{}`
+	})
+
+	require.NoError(t, b.UpdateRole("globalRoles", "admin", "", []string{"hudson.model.Hudson.Administer"}))
+	require.Equal(t, &matrixRequest{
+		Operation:   "addRole",
+		Type:        "globalRoles",
+		Name:        "admin",
+		Permissions: []string{"hudson.model.Hudson.Administer"},
+		Overwrite:   true,
+	}, got)
+}
+
+func TestMatrixBackend_Errors(t *testing.T) {
+	b := newMatrixBackend(t, func(req *matrixRequest) string {
+		if req.Operation == "assignRole" {
+			return `{"error":"role developer is not found"}`
+		}
+
+		return `groovy.lang.MissingPropertyException: No such property`
+	})
+
+	err := b.AssignRole("projectRoles", "developer", "devs")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "role developer is not found")
+
+	err = b.UnAssignRole("projectRoles", "developer", "devs")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unexpected output")
+}
+
+func TestJenkinsClient_authorization(t *testing.T) {
+	jc := JenkinsClient{}
+	require.IsType(t, roleStrategyBackend{}, jc.authorization())
+
+	jc.instance = &jenkinsApi.Jenkins{Spec: jenkinsApi.JenkinsSpec{
+		AuthorizationStrategy: jenkinsApi.AuthorizationStrategyMatrix,
+	}}
+	require.IsType(t, matrixBackend{}, jc.authorization())
+}
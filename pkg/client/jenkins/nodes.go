@@ -0,0 +1,66 @@
+package jenkins
+
+const agentNodesScript = "agent-nodes"
+
+// AgentNode is the permanent agent node launched by an inbound agent, all settings are applied as given.
+type AgentNode struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	RemoteFS    string `json:"remoteFS"`
+	Labels      string `json:"labels"`
+	Executors   int32  `json:"executors"`
+	Exclusive   bool   `json:"exclusive"`
+	WebSocket   bool   `json:"webSocket"`
+}
+
+// nodeManager manages agent nodes with the agent-nodes tech script run in the script console.
+type nodeManager struct {
+	jc JenkinsClient
+	// script is the content of the tech script, it is read from the tech scripts directory if empty.
+	script string
+}
+
+type nodeRequest struct {
+	Operation string     `json:"operation"`
+	Node      *AgentNode `json:"node,omitempty"`
+	Name      string     `json:"name,omitempty"`
+}
+
+type nodeResponse struct {
+	Secret string `json:"secret"`
+}
+
+func (m nodeManager) SaveAgentNode(node *AgentNode) (string, error) {
+	rsp, err := m.run(&nodeRequest{Operation: "saveNode", Node: node})
+	if err != nil {
+		return "", err
+	}
+
+	return rsp.Secret, nil
+}
+
+func (m nodeManager) DeleteAgentNode(name string) error {
+	_, err := m.run(&nodeRequest{Operation: "deleteNode", Name: name})
+
+	return err
+}
+
+func (m nodeManager) run(req *nodeRequest) (*nodeResponse, error) {
+	var rsp nodeResponse
+
+	if err := m.jc.runTechScriptRequest(agentNodesScript, m.script, req.Operation, req, &rsp); err != nil {
+		return nil, err
+	}
+
+	return &rsp, nil
+}
+
+// SaveAgentNode creates the node or updates its settings, it returns the secret the inbound agent connects with.
+func (jc JenkinsClient) SaveAgentNode(node *AgentNode) (string, error) {
+	return nodeManager{jc: jc}.SaveAgentNode(node)
+}
+
+// DeleteAgentNode deletes the node, it does nothing if there is no such node.
+func (jc JenkinsClient) DeleteAgentNode(name string) error {
+	return nodeManager{jc: jc}.DeleteAgentNode(name)
+}
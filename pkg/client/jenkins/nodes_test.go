@@ -0,0 +1,66 @@
+package jenkins
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newNodeManager returns the node manager with the script console responding with respond.
+func newNodeManager(t *testing.T, respond func(req *nodeRequest) string) nodeManager {
+	t.Helper()
+
+	jc := newScriptConsoleClient(t, func(data []byte) string {
+		var req nodeRequest
+		require.NoError(t, json.Unmarshal(data, &req))
+
+		return respond(&req)
+	})
+
+	return nodeManager{
+		jc:     jc,
+		script: "println('test')",
+	}
+}
+
+func TestNodeManager_SaveAgentNode(t *testing.T) {
+	node := &AgentNode{
+		Name:      "pool-0",
+		RemoteFS:  "/home/jenkins/agent",
+		Labels:    "pool licensed",
+		Executors: 2,
+	}
+
+	var got *nodeRequest
+
+	m := newNodeManager(t, func(req *nodeRequest) string {
+		got = req
+
+		return `{"secret":"s3cr3t"}`
+	})
+
+	secret, err := m.SaveAgentNode(node)
+	require.NoError(t, err)
+	require.Equal(t, "s3cr3t", secret)
+	require.Equal(t, &nodeRequest{Operation: "saveNode", Node: node}, got)
+
+	m = newNodeManager(t, func(req *nodeRequest) string {
+		return `{"error":"node pool-0 is not a permanent agent"}`
+	})
+
+	_, err = m.SaveAgentNode(node)
+	require.EqualError(t, err, "node pool-0 is not a permanent agent")
+}
+
+func TestNodeManager_DeleteAgentNode(t *testing.T) {
+	m := newNodeManager(t, func(req *nodeRequest) string {
+		if req.Operation != "deleteNode" || req.Name != "pool-1" {
+			return `{"error":"unexpected request"}`
+		}
+
+		return `{}`
+	})
+
+	require.NoError(t, m.DeleteAgentNode("pool-1"))
+}
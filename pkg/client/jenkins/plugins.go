@@ -0,0 +1,157 @@
+package jenkins
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Plugin is a plugin installed in Jenkins.
+type Plugin struct {
+	ShortName string `json:"shortName"`
+	Version   string `json:"version"`
+	Active    bool   `json:"active"`
+	Deleted   bool   `json:"deleted"`
+}
+
+type pluginManagerResponse struct {
+	Plugins []Plugin `json:"plugins"`
+}
+
+type updateCenterResponse struct {
+	RestartRequiredForCompletion bool `json:"restartRequiredForCompletion"`
+}
+
+// GetPlugins returns plugins installed in Jenkins.
+func (jc JenkinsClient) GetPlugins() ([]Plugin, error) {
+	var result pluginManagerResponse
+
+	rsp, err := jc.resty.R().
+		SetQueryParam("tree", "plugins[shortName,version,active,deleted]").
+		Get("/pluginManager/api/json")
+	if err = parseRestyResponse(rsp, err); err != nil {
+		return nil, fmt.Errorf("failed to get plugins: %w", err)
+	}
+
+	if err = json.Unmarshal(rsp.Body(), &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal plugins: %w", err)
+	}
+
+	return result.Plugins, nil
+}
+
+// InstallPlugins installs plugins from the update center, plugins are given as name@version or name@latest.
+func (jc JenkinsClient) InstallPlugins(plugins []string) error {
+	headers, err := jc.crumbHeaders()
+	if err != nil {
+		return err
+	}
+
+	var body strings.Builder
+
+	body.WriteString("<jenkins>")
+
+	for _, p := range plugins {
+		fmt.Fprintf(&body, `<install plugin="%s" />`, p)
+	}
+
+	body.WriteString("</jenkins>")
+
+	headers["Content-Type"] = "text/xml"
+
+	rsp, err := jc.resty.R().
+		SetHeaders(headers).
+		SetBody(body.String()).
+		Post("/pluginManager/installNecessaryPlugins")
+	if err = parseRestyResponse(rsp, err); err != nil {
+		return fmt.Errorf("failed to install plugins: %w", err)
+	}
+
+	return nil
+}
+
+// DeployPlugin installs the plugin downloaded from pluginURL, it is used to install the exact plugin version.
+func (jc JenkinsClient) DeployPlugin(pluginURL string) error {
+	headers, err := jc.crumbHeaders()
+	if err != nil {
+		return err
+	}
+
+	rsp, err := jc.resty.R().
+		SetHeaders(headers).
+		SetMultipartFields().
+		SetFormData(map[string]string{"pluginUrl": pluginURL}).
+		Post("/pluginManager/uploadPlugin")
+	if err = parseRestyResponse(rsp, err); err != nil {
+		return fmt.Errorf("failed to deploy plugin from %s: %w", pluginURL, err)
+	}
+
+	return nil
+}
+
+// UninstallPlugin uninstalls the plugin, it is removed after Jenkins is restarted.
+func (jc JenkinsClient) UninstallPlugin(name string) error {
+	headers, err := jc.crumbHeaders()
+	if err != nil {
+		return err
+	}
+
+	rsp, err := jc.resty.R().
+		SetHeaders(headers).
+		Post(fmt.Sprintf("/pluginManager/plugin/%s/doUninstall", name))
+	if err = parseRestyResponse(rsp, err); err != nil {
+		return fmt.Errorf("failed to uninstall plugin %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// IsRestartRequired checks whether Jenkins has to be restarted to complete plugin changes.
+func (jc JenkinsClient) IsRestartRequired() (bool, error) {
+	var result updateCenterResponse
+
+	rsp, err := jc.resty.R().
+		SetQueryParam("tree", "restartRequiredForCompletion").
+		Get("/updateCenter/api/json")
+	if err = parseRestyResponse(rsp, err); err != nil {
+		return false, fmt.Errorf("failed to get update center state: %w", err)
+	}
+
+	if err = json.Unmarshal(rsp.Body(), &result); err != nil {
+		return false, fmt.Errorf("failed to unmarshal update center state: %w", err)
+	}
+
+	return result.RestartRequiredForCompletion, nil
+}
+
+// SafeRestart restarts Jenkins once running builds are finished.
+func (jc JenkinsClient) SafeRestart() error {
+	headers, err := jc.crumbHeaders()
+	if err != nil {
+		return err
+	}
+
+	rsp, err := jc.resty.R().
+		SetHeaders(headers).
+		Post("/safeRestart")
+	if err = parseRestyResponse(rsp, err); err != nil {
+		return fmt.Errorf("failed to schedule safe restart: %w", err)
+	}
+
+	return nil
+}
+
+func (jc JenkinsClient) crumbHeaders() (map[string]string, error) {
+	crumb, err := jc.GetCrumb()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get crumb: %w", err)
+	}
+
+	headers := make(map[string]string)
+
+	if crumb != "" {
+		headers[jenkinsCrumbKey] = crumb
+	}
+
+	return headers, nil
+}
@@ -0,0 +1,122 @@
+package jenkins
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/resty.v1"
+)
+
+// newPluginManagerClient returns the client with the crumb issuer responding with the crumb.
+func newPluginManagerClient() JenkinsClient {
+	restyClient := resty.New()
+	httpmock.ActivateNonDefault(restyClient.GetClient())
+
+	httpmock.RegisterResponder(http.MethodGet, "/crumbIssuer/api/json",
+		httpmock.NewStringResponder(http.StatusOK, `{"crumb":"cr"}`))
+
+	return JenkinsClient{resty: restyClient}
+}
+
+func TestJenkinsClient_GetPlugins(t *testing.T) {
+	jc := newPluginManagerClient()
+
+	httpmock.RegisterResponder(http.MethodGet, "/pluginManager/api/json",
+		httpmock.NewStringResponder(http.StatusOK,
+			`{"plugins":[{"shortName":"git","version":"4.11.0","active":true},{"shortName":"ssh","version":"1.0","deleted":true}]}`))
+
+	plugins, err := jc.GetPlugins()
+	require.NoError(t, err)
+	require.Equal(t, []Plugin{
+		{ShortName: "git", Version: "4.11.0", Active: true},
+		{ShortName: "ssh", Version: "1.0", Deleted: true},
+	}, plugins)
+}
+
+func TestJenkinsClient_GetPlugins_Err(t *testing.T) {
+	jc := newPluginManagerClient()
+
+	httpmock.RegisterResponder(http.MethodGet, "/pluginManager/api/json",
+		httpmock.NewStringResponder(http.StatusForbidden, "forbidden"))
+
+	_, err := jc.GetPlugins()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to get plugins")
+}
+
+func TestJenkinsClient_InstallPlugins(t *testing.T) {
+	jc := newPluginManagerClient()
+
+	httpmock.RegisterResponder(http.MethodPost, "/pluginManager/installNecessaryPlugins",
+		func(req *http.Request) (*http.Response, error) {
+			body, err := io.ReadAll(req.Body)
+			if err != nil {
+				return nil, err
+			}
+
+			if req.Header.Get(jenkinsCrumbKey) != "cr" ||
+				string(body) != `<jenkins><install plugin="git@4.11.0" /><install plugin="ssh@latest" /></jenkins>` {
+				return httpmock.NewStringResponse(http.StatusBadRequest, string(body)), nil
+			}
+
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	require.NoError(t, jc.InstallPlugins([]string{"git@4.11.0", "ssh@latest"}))
+}
+
+func TestJenkinsClient_DeployPlugin(t *testing.T) {
+	jc := newPluginManagerClient()
+
+	httpmock.RegisterResponder(http.MethodPost, "/pluginManager/uploadPlugin",
+		func(req *http.Request) (*http.Response, error) {
+			if err := req.ParseMultipartForm(1024); err != nil {
+				return nil, err
+			}
+
+			if req.MultipartForm.Value["pluginUrl"][0] != "https://updates.jenkins.io/download/plugins/git/4.11.0/git.hpi" {
+				return httpmock.NewStringResponse(http.StatusBadRequest, ""), nil
+			}
+
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	require.NoError(t, jc.DeployPlugin("https://updates.jenkins.io/download/plugins/git/4.11.0/git.hpi"))
+}
+
+func TestJenkinsClient_UninstallPlugin(t *testing.T) {
+	jc := newPluginManagerClient()
+
+	httpmock.RegisterResponder(http.MethodPost, "/pluginManager/plugin/git/doUninstall",
+		httpmock.NewStringResponder(http.StatusOK, ""))
+
+	require.NoError(t, jc.UninstallPlugin("git"))
+
+	httpmock.RegisterResponder(http.MethodPost, "/pluginManager/plugin/git/doUninstall",
+		httpmock.NewStringResponder(http.StatusNotFound, ""))
+
+	require.Error(t, jc.UninstallPlugin("git"))
+}
+
+func TestJenkinsClient_IsRestartRequired(t *testing.T) {
+	jc := newPluginManagerClient()
+
+	httpmock.RegisterResponder(http.MethodGet, "/updateCenter/api/json",
+		httpmock.NewStringResponder(http.StatusOK, `{"restartRequiredForCompletion":true}`))
+
+	required, err := jc.IsRestartRequired()
+	require.NoError(t, err)
+	require.True(t, required)
+}
+
+func TestJenkinsClient_SafeRestart(t *testing.T) {
+	jc := newPluginManagerClient()
+
+	httpmock.RegisterResponder(http.MethodPost, "/safeRestart",
+		httpmock.NewStringResponder(http.StatusFound, ""))
+
+	require.NoError(t, jc.SafeRestart())
+}
@@ -3,9 +3,13 @@ package jenkins
 import (
 	"errors"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 
 	"gopkg.in/resty.v1"
+
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
 )
 
 const (
@@ -21,26 +25,101 @@ type Role struct {
 
 var ErrNotFound = errors.New("role is not found")
 
+const getPermissionIDsScript = "hudson.security.Permission.getAll().each { println(it.id) }"
+
+// GrantedPermissions returns IDs of the permissions granted by the role.
+func (r *Role) GrantedPermissions() []string {
+	permissions := make([]string, 0, len(r.PermissionIDs))
+
+	for id, granted := range r.PermissionIDs {
+		if granted {
+			permissions = append(permissions, id)
+		}
+	}
+
+	sort.Strings(permissions)
+
+	return permissions
+}
+
 func IsErrNotFound(err error) bool {
 	return errors.Is(err, ErrNotFound)
 }
 
-// AddRole add role to jenkins
+// AuthorizationBackend manages roles and their assignments in the authorization plugin used by Jenkins.
 // roleType - type of role, available options: globalRoles, projectRoles, nodeRoles.
+type AuthorizationBackend interface {
+	AddRole(roleType, name, pattern string, permissions []string) error
+	UpdateRole(roleType, name, pattern string, permissions []string) error
+	RemoveRoles(roleType string, roleNames []string) error
+	AssignRole(roleType, roleName, subject string) error
+	UnAssignRole(roleType, roleName, subject string) error
+	GetRole(roleType, roleName string) (*Role, error)
+}
+
+// authorization returns the backend selected by the authorization strategy of the Jenkins instance.
+func (jc JenkinsClient) authorization() AuthorizationBackend {
+	if jc.instance != nil && jc.instance.Spec.AuthorizationStrategy == jenkinsApi.AuthorizationStrategyMatrix {
+		return matrixBackend{jc: jc}
+	}
+
+	return roleStrategyBackend{resty: jc.resty}
+}
+
+// AddRole add role to jenkins.
 func (jc JenkinsClient) AddRole(roleType, name, pattern string, permissions []string) error {
-	rsp, err := jc.resty.R().SetFormData(map[string]string{
+	return jc.authorization().AddRole(roleType, name, pattern, permissions)
+}
+
+// UpdateRole overwrites the existing role in jenkins.
+// Jenkins may drop assignments of the overwritten role, so they must be restored by the caller.
+func (jc JenkinsClient) UpdateRole(roleType, name, pattern string, permissions []string) error {
+	return jc.authorization().UpdateRole(roleType, name, pattern, permissions)
+}
+
+func (jc JenkinsClient) RemoveRoles(roleType string, roleNames []string) error {
+	return jc.authorization().RemoveRoles(roleType, roleNames)
+}
+
+func (jc JenkinsClient) AssignRole(roleType, roleName, subject string) error {
+	return jc.authorization().AssignRole(roleType, roleName, subject)
+}
+
+func (jc JenkinsClient) UnAssignRole(roleType, roleName, subject string) error {
+	return jc.authorization().UnAssignRole(roleType, roleName, subject)
+}
+
+func (jc JenkinsClient) GetRole(roleType, roleName string) (*Role, error) {
+	return jc.authorization().GetRole(roleType, roleName)
+}
+
+// roleStrategyBackend manages roles with the Role Strategy plugin REST API.
+type roleStrategyBackend struct {
+	resty *resty.Client
+}
+
+func (b roleStrategyBackend) AddRole(roleType, name, pattern string, permissions []string) error {
+	return b.addRole(roleType, name, pattern, permissions, false)
+}
+
+func (b roleStrategyBackend) UpdateRole(roleType, name, pattern string, permissions []string) error {
+	return b.addRole(roleType, name, pattern, permissions, true)
+}
+
+func (b roleStrategyBackend) addRole(roleType, name, pattern string, permissions []string, overwrite bool) error {
+	rsp, err := b.resty.R().SetFormData(map[string]string{
 		crTypeKey:       roleType,
 		crRoleNameKey:   name,
 		"pattern":       pattern,
 		"permissionIds": strings.Join(permissions, ","),
-		"overwrite":     "false",
+		"overwrite":     strconv.FormatBool(overwrite),
 	}).Post("/role-strategy/strategy/addRole")
 
 	return parseRestyResponse(rsp, err)
 }
 
-func (jc JenkinsClient) RemoveRoles(roleType string, roleNames []string) error {
-	rsp, err := jc.resty.R().SetFormData(map[string]string{
+func (b roleStrategyBackend) RemoveRoles(roleType string, roleNames []string) error {
+	rsp, err := b.resty.R().SetFormData(map[string]string{
 		crTypeKey:   roleType,
 		"roleNames": strings.Join(roleNames, ","),
 	}).Post("/role-strategy/strategy/removeRoles")
@@ -48,12 +127,12 @@ func (jc JenkinsClient) RemoveRoles(roleType string, roleNames []string) error {
 	return parseRestyResponse(rsp, err)
 }
 
-func (jc JenkinsClient) AssignRole(roleType, roleName, subject string) error {
-	if _, err := jc.GetRole(roleType, roleName); err != nil {
+func (b roleStrategyBackend) AssignRole(roleType, roleName, subject string) error {
+	if _, err := b.GetRole(roleType, roleName); err != nil {
 		return fmt.Errorf("failed to get role: %w", err)
 	}
 
-	rsp, err := jc.resty.R().SetFormData(map[string]string{
+	rsp, err := b.resty.R().SetFormData(map[string]string{
 		crTypeKey:     roleType,
 		crRoleNameKey: roleName,
 		"sid":         subject,
@@ -62,8 +141,8 @@ func (jc JenkinsClient) AssignRole(roleType, roleName, subject string) error {
 	return parseRestyResponse(rsp, err)
 }
 
-func (jc JenkinsClient) UnAssignRole(roleType, roleName, subject string) error {
-	rsp, err := jc.resty.R().SetFormData(map[string]string{
+func (b roleStrategyBackend) UnAssignRole(roleType, roleName, subject string) error {
+	rsp, err := b.resty.R().SetFormData(map[string]string{
 		crTypeKey:     roleType,
 		crRoleNameKey: roleName,
 		"sid":         subject,
@@ -72,10 +151,10 @@ func (jc JenkinsClient) UnAssignRole(roleType, roleName, subject string) error {
 	return parseRestyResponse(rsp, err)
 }
 
-func (jc JenkinsClient) GetRole(roleType, roleName string) (*Role, error) {
+func (b roleStrategyBackend) GetRole(roleType, roleName string) (*Role, error) {
 	var r Role
 
-	rsp, err := jc.resty.R().SetFormData(map[string]string{
+	rsp, err := b.resty.R().SetFormData(map[string]string{
 		crTypeKey:     roleType,
 		crRoleNameKey: roleName,
 	}).SetResult(&r).Post("/role-strategy/strategy/getRole")
@@ -91,6 +170,42 @@ func (jc JenkinsClient) GetRole(roleType, roleName string) (*Role, error) {
 	return &r, nil
 }
 
+// GetPermissionIDs returns IDs of all permissions known to jenkins, including the ones contributed by plugins.
+func (jc JenkinsClient) GetPermissionIDs() ([]string, error) {
+	out, err := jc.runScriptWithOutput(getPermissionIDsScript)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get permission ids: %w", err)
+	}
+
+	var ids []string
+
+	for _, line := range strings.Split(out, "\n") {
+		if id := strings.TrimSpace(line); id != "" {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids, nil
+}
+
+// runScriptWithOutput runs the groovy script in the Jenkins script console and returns its output.
+func (jc JenkinsClient) runScriptWithOutput(script string) (string, error) {
+	headers, err := jc.crumbHeaders()
+	if err != nil {
+		return "", err
+	}
+
+	rsp, err := jc.resty.R().
+		SetFormData(map[string]string{"script": script}).
+		SetHeaders(headers).
+		Post("/scriptText")
+	if err = parseRestyResponse(rsp, err); err != nil {
+		return "", err
+	}
+
+	return rsp.String(), nil
+}
+
 func parseRestyResponse(rsp *resty.Response, err error) error {
 	if err != nil {
 		return fmt.Errorf("failed to perform post request: %w", err)
@@ -56,6 +56,36 @@ func TestJenkinsClient_AddRole(t *testing.T) {
 	require.NoError(t, jc.AddRole("rt", "rn", "/*/", []string{"per"}))
 }
 
+func TestJenkinsClient_UpdateRole(t *testing.T) {
+	restyClient := resty.New()
+	httpmock.ActivateNonDefault(restyClient.GetClient())
+
+	jc := JenkinsClient{
+		resty: restyClient,
+	}
+
+	httpmock.RegisterResponder(http.MethodPost, "/role-strategy/strategy/addRole",
+		func(req *http.Request) (*http.Response, error) {
+			if err := req.ParseForm(); err != nil {
+				return nil, err
+			}
+
+			if req.PostForm.Get("overwrite") != "true" {
+				return httpmock.NewStringResponse(http.StatusBadRequest, "overwrite is not set"), nil
+			}
+
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	require.NoError(t, jc.UpdateRole("rt", "rn", "/*/", []string{"per"}))
+}
+
+func TestRole_GrantedPermissions(t *testing.T) {
+	r := Role{PermissionIDs: map[string]bool{"b": true, "a": true, "c": false}}
+
+	require.Equal(t, []string{"a", "b"}, r.GrantedPermissions())
+}
+
 func TestJenkinsClient_AssignRole(t *testing.T) {
 	restyClient := resty.New()
 	httpmock.ActivateNonDefault(restyClient.GetClient())
@@ -165,3 +195,21 @@ func TestJenkinsClient_GetRoleFailure_500(t *testing.T) {
 
 	require.Contains(t, err.Error(), "status: 500")
 }
+
+func TestJenkinsClient_GetPermissionIDs(t *testing.T) {
+	restyClient := resty.New()
+	httpmock.ActivateNonDefault(restyClient.GetClient())
+
+	jc := JenkinsClient{
+		resty: restyClient,
+	}
+
+	httpmock.RegisterResponder(http.MethodGet, "/crumbIssuer/api/json",
+		httpmock.NewStringResponder(http.StatusNotFound, ""))
+	httpmock.RegisterResponder(http.MethodPost, "/scriptText",
+		httpmock.NewStringResponder(http.StatusOK, "hudson.model.Hudson.Administer\nhudson.model.Item.Read\n\n"))
+
+	ids, err := jc.GetPermissionIDs()
+	require.NoError(t, err)
+	require.Equal(t, []string{"hudson.model.Hudson.Administer", "hudson.model.Item.Read"}, ids)
+}
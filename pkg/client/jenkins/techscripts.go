@@ -0,0 +1,76 @@
+package jenkins
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	platformHelper "github.com/epam/edp-jenkins-operator/v2/pkg/service/platform/helper"
+)
+
+// techScriptError is the part of the tech script output which reports a failed operation.
+type techScriptError struct {
+	Error string `json:"error,omitempty"`
+}
+
+// runTechScriptRequest runs the request based tech script in the script console and decodes its output into rsp.
+// The tech script is read from the tech scripts directory if script is empty.
+func (jc JenkinsClient) runTechScriptRequest(name, script, operation string, req, rsp interface{}) error {
+	if script == "" {
+		var err error
+
+		if script, err = readTechScript(name); err != nil {
+			return err
+		}
+	}
+
+	data, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s request: %w", name, err)
+	}
+
+	// the request is passed base64 encoded to avoid escaping it in the groovy code.
+	script = fmt.Sprintf("def request = new groovy.json.JsonSlurper().parseText(new String('%s'.decodeBase64(), 'UTF-8'))\n%s",
+		base64.StdEncoding.EncodeToString(data), script)
+
+	out, err := jc.runScriptWithOutput(script)
+	if err != nil {
+		return fmt.Errorf("failed to run %s of %s: %w", operation, name, err)
+	}
+
+	out = strings.TrimSpace(out)
+
+	var scriptErr techScriptError
+
+	if err = json.Unmarshal([]byte(out), &scriptErr); err != nil {
+		return fmt.Errorf("unexpected output of %s: %s", name, out)
+	}
+
+	if scriptErr.Error != "" {
+		return errors.New(scriptErr.Error)
+	}
+
+	if err = json.Unmarshal([]byte(out), rsp); err != nil {
+		return fmt.Errorf("unexpected output of %s: %s", name, out)
+	}
+
+	return nil
+}
+
+func readTechScript(name string) (string, error) {
+	directory, err := platformHelper.CreatePathToTemplateDirectory(defaultTechScriptsDirectory)
+	if err != nil {
+		return "", fmt.Errorf("failed to create path to template dir: %w", err)
+	}
+
+	cn, err := os.ReadFile(filepath.Clean(filepath.Join(directory, name)))
+	if err != nil {
+		return "", fmt.Errorf("failed to read tech script %s: %w", name, err)
+	}
+
+	return string(cn), nil
+}
@@ -0,0 +1,138 @@
+package jenkins
+
+const userManagementScript = "user-management"
+
+// User is a user of the Jenkins own user database.
+type User struct {
+	ID       string `json:"id"`
+	FullName string `json:"fullName"`
+	Email    string `json:"email"`
+}
+
+// APIToken is a Jenkins API token, its value is known only right after it has been generated.
+type APIToken struct {
+	UUID  string `json:"uuid"`
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// userManager manages users and their API tokens with the user-management tech script run in the script console.
+type userManager struct {
+	jc JenkinsClient
+	// script is the content of the tech script, it is read from the tech scripts directory if empty.
+	script string
+}
+
+type userRequest struct {
+	Operation string `json:"operation"`
+	ID        string `json:"id"`
+	Password  string `json:"password,omitempty"`
+	FullName  string `json:"fullName,omitempty"`
+	Email     string `json:"email,omitempty"`
+	TokenName string `json:"tokenName,omitempty"`
+	TokenUUID string `json:"tokenUUID,omitempty"`
+}
+
+type userResponse struct {
+	User   *User      `json:"user,omitempty"`
+	Token  *APIToken  `json:"token,omitempty"`
+	Tokens []APIToken `json:"tokens,omitempty"`
+}
+
+func (m userManager) GetUser(id string) (*User, error) {
+	rsp, err := m.run(&userRequest{Operation: "getUser", ID: id})
+	if err != nil {
+		return nil, err
+	}
+
+	if rsp.User == nil {
+		return nil, ErrNotFound
+	}
+
+	return rsp.User, nil
+}
+
+func (m userManager) SaveUser(id, password, fullName, email string) error {
+	_, err := m.run(&userRequest{Operation: "saveUser", ID: id, Password: password, FullName: fullName, Email: email})
+
+	return err
+}
+
+func (m userManager) DeleteUser(id string) error {
+	_, err := m.run(&userRequest{Operation: "deleteUser", ID: id})
+
+	return err
+}
+
+func (m userManager) CreateAPIToken(userID, tokenName string) (*APIToken, error) {
+	rsp, err := m.run(&userRequest{Operation: "createToken", ID: userID, TokenName: tokenName})
+	if err != nil {
+		return nil, err
+	}
+
+	if rsp.Token == nil {
+		return nil, ErrNotFound
+	}
+
+	return rsp.Token, nil
+}
+
+func (m userManager) ListAPITokens(userID string) ([]APIToken, error) {
+	rsp, err := m.run(&userRequest{Operation: "listTokens", ID: userID})
+	if err != nil {
+		return nil, err
+	}
+
+	return rsp.Tokens, nil
+}
+
+func (m userManager) RevokeAPIToken(userID, tokenUUID string) error {
+	_, err := m.run(&userRequest{Operation: "revokeToken", ID: userID, TokenUUID: tokenUUID})
+
+	return err
+}
+
+func (m userManager) run(req *userRequest) (*userResponse, error) {
+	var rsp userResponse
+
+	if err := m.jc.runTechScriptRequest(userManagementScript, m.script, req.Operation, req, &rsp); err != nil {
+		return nil, err
+	}
+
+	return &rsp, nil
+}
+
+func (jc JenkinsClient) users() userManager {
+	return userManager{jc: jc}
+}
+
+// GetUser returns the user of the Jenkins own user database, ErrNotFound is returned if there is no such user.
+func (jc JenkinsClient) GetUser(id string) (*User, error) {
+	return jc.users().GetUser(id)
+}
+
+// SaveUser creates the user or updates its password, full name and email.
+// Empty values are left unchanged for the existing user.
+func (jc JenkinsClient) SaveUser(id, password, fullName, email string) error {
+	return jc.users().SaveUser(id, password, fullName, email)
+}
+
+// DeleteUser deletes the user, it does nothing if there is no such user.
+func (jc JenkinsClient) DeleteUser(id string) error {
+	return jc.users().DeleteUser(id)
+}
+
+// CreateAPIToken generates a new API token of the user.
+func (jc JenkinsClient) CreateAPIToken(userID, tokenName string) (*APIToken, error) {
+	return jc.users().CreateAPIToken(userID, tokenName)
+}
+
+// ListAPITokens returns API tokens of the user without their values.
+func (jc JenkinsClient) ListAPITokens(userID string) ([]APIToken, error) {
+	return jc.users().ListAPITokens(userID)
+}
+
+// RevokeAPIToken revokes the API token of the user, it does nothing if there is no such token.
+func (jc JenkinsClient) RevokeAPIToken(userID, tokenUUID string) error {
+	return jc.users().RevokeAPIToken(userID, tokenUUID)
+}
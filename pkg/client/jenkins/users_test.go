@@ -0,0 +1,82 @@
+package jenkins
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newUserManager returns the user manager with the script console responding with respond.
+func newUserManager(t *testing.T, respond func(req *userRequest) string) userManager {
+	t.Helper()
+
+	jc := newScriptConsoleClient(t, func(data []byte) string {
+		var req userRequest
+		require.NoError(t, json.Unmarshal(data, &req))
+
+		return respond(&req)
+	})
+
+	return userManager{
+		jc:     jc,
+		script: "println('test')",
+	}
+}
+
+func TestUserManager_GetUser(t *testing.T) {
+	m := newUserManager(t, func(req *userRequest) string {
+		if req.Operation != "getUser" || req.ID != "developer" {
+			return `{}`
+		}
+
+		return `{"user":{"id":"developer","fullName":"Developer","email":"dev@example.com"}}`
+	})
+
+	user, err := m.GetUser("developer")
+	require.NoError(t, err)
+	require.Equal(t, &User{ID: "developer", FullName: "Developer", Email: "dev@example.com"}, user)
+
+	_, err = m.GetUser("unknown")
+	require.True(t, IsErrNotFound(err))
+}
+
+func TestUserManager_SaveUser(t *testing.T) {
+	var got *userRequest
+
+	m := newUserManager(t, func(req *userRequest) string {
+		got = req
+
+		return `{}`
+	})
+
+	require.NoError(t, m.SaveUser("developer", "pwd", "Developer", ""))
+	require.Equal(t, &userRequest{Operation: "saveUser", ID: "developer", Password: "pwd", FullName: "Developer"}, got)
+}
+
+func TestUserManager_APIToken(t *testing.T) {
+	m := newUserManager(t, func(req *userRequest) string {
+		switch req.Operation {
+		case "createToken":
+			return `{"token":{"uuid":"1234","name":"` + req.TokenName + `","value":"secret"}}`
+		case "listTokens":
+			return `{"tokens":[{"uuid":"1234","name":"ci"}]}`
+		case "revokeToken":
+			return `{"error":"user developer is not found"}`
+		default:
+			return `{}`
+		}
+	})
+
+	token, err := m.CreateAPIToken("developer", "ci")
+	require.NoError(t, err)
+	require.Equal(t, &APIToken{UUID: "1234", Name: "ci", Value: "secret"}, token)
+
+	tokens, err := m.ListAPITokens("developer")
+	require.NoError(t, err)
+	require.Equal(t, []APIToken{{UUID: "1234", Name: "ci"}}, tokens)
+
+	err = m.RevokeAPIToken("developer", "1234")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "user developer is not found")
+}
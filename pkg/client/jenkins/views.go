@@ -0,0 +1,169 @@
+package jenkins
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const viewContentType = "application/xml"
+
+// ListView is the list view of the Jenkins root or a folder.
+type ListView struct {
+	Name         string
+	Description  string
+	Jobs         []string
+	IncludeRegex string
+	Recurse      bool
+	// Columns are the class names of the view columns, e.g. hudson.views.StatusColumn.
+	Columns []string
+}
+
+type listViewXML struct {
+	XMLName         xml.Name       `xml:"hudson.model.ListView"`
+	Name            string         `xml:"name"`
+	Description     string         `xml:"description,omitempty"`
+	FilterExecutors bool           `xml:"filterExecutors"`
+	FilterQueue     bool           `xml:"filterQueue"`
+	Properties      classXML       `xml:"properties"`
+	JobNames        viewJobsXML    `xml:"jobNames"`
+	JobFilters      struct{}       `xml:"jobFilters"`
+	Columns         viewColumnsXML `xml:"columns"`
+	IncludeRegex    string         `xml:"includeRegex,omitempty"`
+	Recurse         bool           `xml:"recurse"`
+}
+
+type classXML struct {
+	Class string `xml:"class,attr"`
+}
+
+type viewJobsXML struct {
+	Comparator classXML `xml:"comparator"`
+	Names      []string `xml:"string"`
+}
+
+type viewColumnsXML struct {
+	Columns []viewColumnXML
+}
+
+// viewColumnXML is the column element named after the column class.
+type viewColumnXML struct {
+	XMLName xml.Name
+}
+
+func (v *ListView) config() ([]byte, error) {
+	columns := make([]viewColumnXML, 0, len(v.Columns))
+	for _, c := range v.Columns {
+		columns = append(columns, viewColumnXML{XMLName: xml.Name{Local: c}})
+	}
+
+	config, err := xml.Marshal(listViewXML{
+		Name:        v.Name,
+		Description: v.Description,
+		Properties:  classXML{Class: "hudson.model.View$PropertyList"},
+		JobNames: viewJobsXML{
+			Comparator: classXML{Class: "hudson.util.CaseInsensitiveComparator"},
+			Names:      v.Jobs,
+		},
+		Columns:      viewColumnsXML{Columns: columns},
+		IncludeRegex: v.IncludeRegex,
+		Recurse:      v.Recurse,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal view %s: %w", v.Name, err)
+	}
+
+	return config, nil
+}
+
+// SaveListView creates the list view in the folder or replaces its configuration. The view is created
+// in the Jenkins root if the folder is empty.
+func (jc JenkinsClient) SaveListView(folder string, view *ListView) error {
+	config, err := view.config()
+	if err != nil {
+		return err
+	}
+
+	exists, err := jc.viewExists(folder, view.Name)
+	if err != nil {
+		return err
+	}
+
+	headers, err := jc.crumbHeaders()
+	if err != nil {
+		return err
+	}
+
+	headers["Content-Type"] = viewContentType
+
+	req := jc.resty.R().
+		SetHeaders(headers).
+		SetBody(config)
+
+	path := fmt.Sprintf("%s/view/%s/config.xml", folderPath(folder), url.PathEscape(view.Name))
+	if !exists {
+		req.SetQueryParam("name", view.Name)
+		path = fmt.Sprintf("%s/createView", folderPath(folder))
+	}
+
+	rsp, err := req.Post(path)
+	if err = parseRestyResponse(rsp, err); err != nil {
+		return fmt.Errorf("failed to save view %s: %w", view.Name, err)
+	}
+
+	return nil
+}
+
+// DeleteListView deletes the view from the folder, it does nothing if there is no such view.
+func (jc JenkinsClient) DeleteListView(folder, name string) error {
+	headers, err := jc.crumbHeaders()
+	if err != nil {
+		return err
+	}
+
+	rsp, err := jc.resty.R().
+		SetHeaders(headers).
+		Post(fmt.Sprintf("%s/view/%s/doDelete", folderPath(folder), url.PathEscape(name)))
+	if err == nil && rsp.StatusCode() == http.StatusNotFound {
+		return nil
+	}
+
+	if err = parseRestyResponse(rsp, err); err != nil {
+		return fmt.Errorf("failed to delete view %s: %w", name, err)
+	}
+
+	return nil
+}
+
+func (jc JenkinsClient) viewExists(folder, name string) (bool, error) {
+	rsp, err := jc.resty.R().
+		SetQueryParam("tree", "name").
+		Get(fmt.Sprintf("%s/view/%s/api/json", folderPath(folder), url.PathEscape(name)))
+	if err == nil && rsp.StatusCode() == http.StatusNotFound {
+		return false, nil
+	}
+
+	if err = parseRestyResponse(rsp, err); err != nil {
+		return false, fmt.Errorf("failed to get view %s: %w", name, err)
+	}
+
+	return true, nil
+}
+
+// folderPath returns the URL path of the folder given as a slash separated full name.
+func folderPath(folder string) string {
+	if folder == "" {
+		return ""
+	}
+
+	var path strings.Builder
+
+	for _, name := range strings.Split(folder, "/") {
+		path.WriteString("/job/")
+		path.WriteString(url.PathEscape(name))
+	}
+
+	return path.String()
+}
@@ -0,0 +1,89 @@
+package jenkins
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+)
+
+func getTestListView() *ListView {
+	return &ListView{
+		Name:         "release",
+		Description:  "Release jobs",
+		Jobs:         []string{"deploy"},
+		IncludeRegex: "release-.*",
+		Columns:      []string{"hudson.views.StatusColumn", "hudson.views.JobColumn"},
+	}
+}
+
+func TestListView_config(t *testing.T) {
+	config, err := getTestListView().config()
+	require.NoError(t, err)
+	require.Equal(t, `<hudson.model.ListView><name>release</name><description>Release jobs</description>`+
+		`<filterExecutors>false</filterExecutors><filterQueue>false</filterQueue>`+
+		`<properties class="hudson.model.View$PropertyList"></properties>`+
+		`<jobNames><comparator class="hudson.util.CaseInsensitiveComparator"></comparator><string>deploy</string></jobNames>`+
+		`<jobFilters></jobFilters>`+
+		`<columns><hudson.views.StatusColumn></hudson.views.StatusColumn><hudson.views.JobColumn></hudson.views.JobColumn></columns>`+
+		`<includeRegex>release-.*</includeRegex><recurse>false</recurse></hudson.model.ListView>`, string(config))
+}
+
+func TestJenkinsClient_SaveListView_Create(t *testing.T) {
+	jc := newPluginManagerClient()
+
+	httpmock.RegisterResponder(http.MethodGet, "/job/team/view/release/api/json",
+		httpmock.NewStringResponder(http.StatusNotFound, ""))
+	httpmock.RegisterResponder(http.MethodPost, "/job/team/createView",
+		func(req *http.Request) (*http.Response, error) {
+			body, err := io.ReadAll(req.Body)
+			if err != nil {
+				return nil, err
+			}
+
+			if req.Header.Get(jenkinsCrumbKey) != "cr" || req.URL.Query().Get("name") != "release" ||
+				req.Header.Get("Content-Type") != viewContentType || len(body) == 0 {
+				return httpmock.NewStringResponse(http.StatusBadRequest, ""), nil
+			}
+
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	require.NoError(t, jc.SaveListView("team", getTestListView()))
+}
+
+func TestJenkinsClient_SaveListView_Update(t *testing.T) {
+	jc := newPluginManagerClient()
+
+	httpmock.RegisterResponder(http.MethodGet, "/view/release/api/json",
+		httpmock.NewStringResponder(http.StatusOK, `{"name":"release"}`))
+	httpmock.RegisterResponder(http.MethodPost, "/view/release/config.xml",
+		httpmock.NewStringResponder(http.StatusOK, ""))
+
+	require.NoError(t, jc.SaveListView("", getTestListView()))
+}
+
+func TestJenkinsClient_SaveListView_Err(t *testing.T) {
+	jc := newPluginManagerClient()
+
+	httpmock.RegisterResponder(http.MethodGet, "/view/release/api/json",
+		httpmock.NewStringResponder(http.StatusForbidden, "forbidden"))
+
+	err := jc.SaveListView("", getTestListView())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to get view release")
+}
+
+func TestJenkinsClient_DeleteListView(t *testing.T) {
+	jc := newPluginManagerClient()
+
+	httpmock.RegisterResponder(http.MethodPost, "/job/team/job/sub/view/release/doDelete",
+		httpmock.NewStringResponder(http.StatusOK, ""))
+	httpmock.RegisterResponder(http.MethodPost, "/view/gone/doDelete",
+		httpmock.NewStringResponder(http.StatusNotFound, ""))
+
+	require.NoError(t, jc.DeleteListView("team/sub", "release"))
+	require.NoError(t, jc.DeleteListView("", "gone"))
+}
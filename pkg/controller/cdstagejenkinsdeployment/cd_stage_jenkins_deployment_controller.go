@@ -11,7 +11,6 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -20,14 +19,13 @@ import (
 
 	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
 	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/cdstagejenkinsdeployment/chain"
-	cdStageJenkinshelper "github.com/epam/edp-jenkins-operator/v2/pkg/controller/cdstagejenkinsdeployment/helper"
 	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/helper"
 	ps "github.com/epam/edp-jenkins-operator/v2/pkg/service/platform"
-	"github.com/epam/edp-jenkins-operator/v2/pkg/util/consts"
 )
 
 const (
 	baseTimeoutDuration = 500 * time.Millisecond
+	deployPollInterval  = 10 * time.Second
 )
 
 func NewReconcileCDStageJenkinsDeployment(k8sClient client.Client, scheme *runtime.Scheme, log logr.Logger) *ReconcileCDStageJenkinsDeployment {
@@ -78,21 +76,18 @@ func (r *ReconcileCDStageJenkinsDeployment) Reconcile(ctx context.Context, reque
 		return reconcile.Result{}, fmt.Errorf("failed to get CDStageJenkinsDeployment: %w", err)
 	}
 
+	if cdStageJenkinsDeployment.IsCompleted() {
+		log.Info("deployment has been already completed", "status", cdStageJenkinsDeployment.Status.Status)
+
+		return reconcile.Result{}, nil
+	}
+
 	defer func() {
 		if err := r.updateStatus(ctx, cdStageJenkinsDeployment); err != nil {
 			log.Error(err, "error during status updating")
 		}
 	}()
 
-	if err := r.setOwnerReference(cdStageJenkinsDeployment); err != nil {
-		wrappedError := fmt.Errorf("failed to set owner ref for %v CDStageJenkinsDeployment: %w",
-			cdStageJenkinsDeployment.Name, err)
-
-		cdStageJenkinsDeployment.SetFailedStatus(wrappedError)
-
-		return reconcile.Result{}, wrappedError
-	}
-
 	env, err := helper.GetPlatformTypeEnv()
 	if err != nil {
 		return reconcile.Result{}, fmt.Errorf("failed to GetPlatformTypeEnv: %w", err)
@@ -114,6 +109,15 @@ func (r *ReconcileCDStageJenkinsDeployment) Reconcile(ctx context.Context, reque
 		return reconcile.Result{RequeueAfter: p}, nil
 	}
 
+	cdStageJenkinsDeployment.Status.FailureCount = 0
+
+	if !cdStageJenkinsDeployment.IsCompleted() {
+		log.Info("deployment is not finished yet", "status", cdStageJenkinsDeployment.Status.Status,
+			"next reconciliation in", deployPollInterval)
+
+		return reconcile.Result{RequeueAfter: deployPollInterval}, nil
+	}
+
 	log.Info("Reconciling has been finished")
 
 	return reconcile.Result{}, nil
@@ -137,20 +141,3 @@ func (r *ReconcileCDStageJenkinsDeployment) updateStatus(ctx context.Context, je
 
 	return nil
 }
-
-func (r *ReconcileCDStageJenkinsDeployment) setOwnerReference(jenkinsDeployment *jenkinsApi.CDStageJenkinsDeployment) error {
-	s, err := cdStageJenkinshelper.GetCDStageDeploy(r.client, jenkinsDeployment.Labels[consts.CdStageDeployKey], jenkinsDeployment.Namespace)
-	if err != nil {
-		return fmt.Errorf("failed to GetCDStageDeploy: %w", err)
-	}
-
-	if err = controllerutil.SetControllerReference(s, jenkinsDeployment, r.scheme); err != nil {
-		return fmt.Errorf("failed to SetControllerReference: %w", err)
-	}
-
-	if err = r.client.Update(context.TODO(), jenkinsDeployment); err != nil {
-		return fmt.Errorf("failed to Update CDStageJenkinsDeployment: %w", err)
-	}
-
-	return nil
-}
@@ -57,9 +57,16 @@ func TestReconcileCDStageJenkinsDeployment_Reconcile(t *testing.T) {
 	assert.Equal(t, reconcile.Result{}, rs)
 }
 
-func TestReconcileCDStageJenkinsDeployment_setOwnerReferenceErr(t *testing.T) {
+func TestReconcileCDStageJenkinsDeployment_NoOwnerReference(t *testing.T) {
 	ctx := context.Background()
 	instance := &jenkinsApi.CDStageJenkinsDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    map[string]string{consts.CdStageDeployKey: name},
+		},
+	}
+	CDStageDeploy := &codebaseApi.CDStageDeploy{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      name,
 			Namespace: namespace,
@@ -68,21 +75,21 @@ func TestReconcileCDStageJenkinsDeployment_setOwnerReferenceErr(t *testing.T) {
 
 	s := runtime.NewScheme()
 	s.AddKnownTypes(v1.SchemeGroupVersion, &jenkinsApi.CDStageJenkinsDeployment{}, &codebaseApi.CDStageDeploy{})
-	cl := fake.NewClientBuilder().WithObjects(instance).WithScheme(s).Build()
+	cl := fake.NewClientBuilder().WithObjects(instance, CDStageDeploy).WithScheme(s).Build()
 
-	log := &common.Logger{}
 	rg := ReconcileCDStageJenkinsDeployment{
 		client: cl,
-		log:    log,
-	}
-	req := reconcile.Request{
-		NamespacedName: nsn,
+		log:    &common.Logger{},
+		scheme: s,
 	}
-	rs, err := rg.Reconcile(ctx, req)
 
+	_, err := rg.Reconcile(ctx, reconcile.Request{NamespacedName: nsn})
 	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "failed to set owner ref for")
-	assert.Equal(t, reconcile.Result{}, rs)
+
+	// the deployment is not garbage collected with the CDStageDeploy deleted when the deployment is finished.
+	checkInstance := &jenkinsApi.CDStageJenkinsDeployment{}
+	assert.NoError(t, cl.Get(ctx, nsn, checkInstance))
+	assert.Empty(t, checkInstance.GetOwnerReferences())
 }
 
 func TestReconcileCDStageJenkinsDeployment_GetPlatformTypeEnvErr(t *testing.T) {
@@ -234,3 +241,36 @@ func TestNewReconcileCDStageJenkinsDeployment(t *testing.T) {
 	}
 	assert.Equal(t, Expected, Reconcile)
 }
+
+func TestReconcileCDStageJenkinsDeployment_Completed(t *testing.T) {
+	ctx := context.Background()
+	instance := &jenkinsApi.CDStageJenkinsDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Status: jenkinsApi.CDStageJenkinsDeploymentStatus{
+			Status:      jenkinsApi.CDStageJenkinsDeploymentStatusFailed,
+			BuildResult: "FAILURE",
+			CleanupDone: true,
+		},
+	}
+
+	s := runtime.NewScheme()
+	s.AddKnownTypes(v1.SchemeGroupVersion, &jenkinsApi.CDStageJenkinsDeployment{})
+	cl := fake.NewClientBuilder().WithObjects(instance).WithScheme(s).Build()
+
+	log := &common.Logger{}
+	rg := ReconcileCDStageJenkinsDeployment{
+		client: cl,
+		log:    log,
+		scheme: s,
+	}
+
+	rs, err := rg.Reconcile(ctx, reconcile.Request{NamespacedName: nsn})
+	assert.NoError(t, err)
+	assert.Equal(t, reconcile.Result{}, rs)
+
+	_, isMsgFound := log.InfoMessages["deployment has been already completed"]
+	assert.True(t, isMsgFound)
+}
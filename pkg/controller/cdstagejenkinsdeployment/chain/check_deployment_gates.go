@@ -0,0 +1,132 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/robfig/cron/v3"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/cdstagejenkinsdeployment/chain/handler"
+)
+
+// CheckDeploymentGates keeps the deployment pending until it is approved and a deployment window is open.
+// The gates are checked only before the deployment starts.
+type CheckDeploymentGates struct {
+	next   handler.CDStageJenkinsDeploymentHandler
+	client client.Client
+	log    logr.Logger
+	now    func() time.Time
+}
+
+func (h CheckDeploymentGates) ServeRequest(jenkinsDeploy *jenkinsApi.CDStageJenkinsDeployment) error {
+	if jenkinsDeploy.IsStarted() {
+		return nextServeOrNil(h.next, jenkinsDeploy)
+	}
+
+	log := h.log.WithValues("job", jenkinsDeploy.GetStageJob().Name)
+
+	if jenkinsDeploy.Spec.ApprovalRequired {
+		approved, err := h.isApproved(jenkinsDeploy)
+		if err != nil {
+			return err
+		}
+
+		if !approved {
+			setPending(jenkinsDeploy, jenkinsApi.PendingReasonAwaitingApproval,
+				fmt.Sprintf("deployment is waiting for approval: set the %s annotation or create a CDStageJenkinsDeploymentApproval",
+					jenkinsApi.ApprovedByAnnotation))
+			log.Info("deployment is waiting for approval")
+
+			return nil
+		}
+	}
+
+	if len(jenkinsDeploy.Spec.Windows) > 0 {
+		open, err := isAnyWindowOpen(jenkinsDeploy.Spec.Windows, h.now())
+		if err != nil {
+			return err
+		}
+
+		if !open {
+			setPending(jenkinsDeploy, jenkinsApi.PendingReasonOutsideWindow,
+				"deployment is waiting for a deployment window to open")
+			log.Info("deployment is outside of deployment windows")
+
+			return nil
+		}
+	}
+
+	jenkinsDeploy.Status.Reason = ""
+
+	return nextServeOrNil(h.next, jenkinsDeploy)
+}
+
+func (h CheckDeploymentGates) isApproved(jenkinsDeploy *jenkinsApi.CDStageJenkinsDeployment) (bool, error) {
+	if jenkinsDeploy.Annotations[jenkinsApi.ApprovedByAnnotation] != "" {
+		return true, nil
+	}
+
+	approvals := &jenkinsApi.CDStageJenkinsDeploymentApprovalList{}
+	if err := h.client.List(context.TODO(), approvals, client.InNamespace(jenkinsDeploy.Namespace)); err != nil {
+		return false, fmt.Errorf("failed to list CDStageJenkinsDeploymentApprovals: %w", err)
+	}
+
+	for i := range approvals.Items {
+		if approvals.Items[i].Spec.Deployment == jenkinsDeploy.Name {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func setPending(jenkinsDeploy *jenkinsApi.CDStageJenkinsDeployment, reason, message string) {
+	jenkinsDeploy.Status.Status = jenkinsApi.CDStageJenkinsDeploymentStatusPending
+	jenkinsDeploy.Status.Reason = reason
+	jenkinsDeploy.Status.Message = message
+}
+
+// isAnyWindowOpen returns true if the time is within any of the deployment windows.
+func isAnyWindowOpen(windows []jenkinsApi.DeploymentWindow, now time.Time) (bool, error) {
+	for i := range windows {
+		open, err := isWindowOpen(&windows[i], now)
+		if err != nil {
+			return false, err
+		}
+
+		if open {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// isWindowOpen returns true if the window has been opened by its schedule within the window duration before now.
+func isWindowOpen(window *jenkinsApi.DeploymentWindow, now time.Time) (bool, error) {
+	schedule, err := cron.ParseStandard(window.Schedule)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse deployment window schedule %q: %w", window.Schedule, err)
+	}
+
+	duration, err := time.ParseDuration(window.Duration)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse deployment window duration %q: %w", window.Duration, err)
+	}
+
+	location := time.UTC
+
+	if window.TimeZone != "" {
+		if location, err = time.LoadLocation(window.TimeZone); err != nil {
+			return false, fmt.Errorf("failed to load deployment window time zone %q: %w", window.TimeZone, err)
+		}
+	}
+
+	now = now.In(location)
+
+	return !schedule.Next(now.Add(-duration)).After(now), nil
+}
@@ -0,0 +1,115 @@
+package chain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+)
+
+func newGatesHandler(t *testing.T, now time.Time, next *nextHandlerMock, objs ...client.Object) CheckDeploymentGates {
+	t.Helper()
+
+	s := runtime.NewScheme()
+	require.NoError(t, jenkinsApi.AddToScheme(s))
+
+	return CheckDeploymentGates{
+		client: fake.NewClientBuilder().WithScheme(s).WithObjects(objs...).Build(),
+		log:    logr.Discard(),
+		now:    func() time.Time { return now },
+		next:   next,
+	}
+}
+
+func pendingDeployment() *jenkinsApi.CDStageJenkinsDeployment {
+	jd := testDeployment()
+	jd.Status = jenkinsApi.CDStageJenkinsDeploymentStatus{}
+
+	return jd
+}
+
+func TestCheckDeploymentGates_ServeRequest_AwaitingApproval(t *testing.T) {
+	jd := pendingDeployment()
+	jd.Spec.ApprovalRequired = true
+
+	next := &nextHandlerMock{}
+
+	require.NoError(t, newGatesHandler(t, time.Now(), next).ServeRequest(jd))
+	assert.False(t, next.called)
+	assert.Equal(t, jenkinsApi.CDStageJenkinsDeploymentStatusPending, jd.Status.Status)
+	assert.Equal(t, jenkinsApi.PendingReasonAwaitingApproval, jd.Status.Reason)
+	assert.False(t, jd.IsCompleted())
+}
+
+func TestCheckDeploymentGates_ServeRequest_ApprovedByAnnotation(t *testing.T) {
+	jd := pendingDeployment()
+	jd.Spec.ApprovalRequired = true
+	jd.Annotations = map[string]string{jenkinsApi.ApprovedByAnnotation: "admin"}
+
+	next := &nextHandlerMock{}
+
+	require.NoError(t, newGatesHandler(t, time.Now(), next).ServeRequest(jd))
+	assert.True(t, next.called)
+}
+
+func TestCheckDeploymentGates_ServeRequest_ApprovedByCR(t *testing.T) {
+	jd := pendingDeployment()
+	jd.Spec.ApprovalRequired = true
+
+	approval := &jenkinsApi.CDStageJenkinsDeploymentApproval{
+		ObjectMeta: metav1.ObjectMeta{Name: "approval", Namespace: "ns"},
+		Spec:       jenkinsApi.CDStageJenkinsDeploymentApprovalSpec{Deployment: jd.Name, ApprovedBy: "admin"},
+	}
+
+	next := &nextHandlerMock{}
+
+	require.NoError(t, newGatesHandler(t, time.Now(), next, approval).ServeRequest(jd))
+	assert.True(t, next.called)
+}
+
+func TestCheckDeploymentGates_ServeRequest_Windows(t *testing.T) {
+	kyiv, err := time.LoadLocation("Europe/Kyiv")
+	require.NoError(t, err)
+
+	jd := pendingDeployment()
+	jd.Spec.Windows = []jenkinsApi.DeploymentWindow{
+		{Schedule: "0 22 * * *", Duration: "2h", TimeZone: "Europe/Kyiv"},
+	}
+
+	next := &nextHandlerMock{}
+
+	require.NoError(t, newGatesHandler(t, time.Date(2022, 6, 1, 12, 0, 0, 0, kyiv), next).ServeRequest(jd))
+	assert.False(t, next.called)
+	assert.Equal(t, jenkinsApi.PendingReasonOutsideWindow, jd.Status.Reason)
+
+	require.NoError(t, newGatesHandler(t, time.Date(2022, 6, 1, 23, 30, 0, 0, kyiv), next).ServeRequest(jd))
+	assert.True(t, next.called)
+	assert.Empty(t, jd.Status.Reason)
+}
+
+func TestCheckDeploymentGates_ServeRequest_InvalidWindow(t *testing.T) {
+	jd := pendingDeployment()
+	jd.Spec.Windows = []jenkinsApi.DeploymentWindow{{Schedule: "invalid", Duration: "1h"}}
+
+	err := newGatesHandler(t, time.Now(), &nextHandlerMock{}).ServeRequest(jd)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to parse deployment window schedule")
+}
+
+func TestCheckDeploymentGates_ServeRequest_Started(t *testing.T) {
+	jd := testDeployment()
+	jd.Spec.ApprovalRequired = true
+
+	next := &nextHandlerMock{}
+
+	require.NoError(t, newGatesHandler(t, time.Now(), next).ServeRequest(jd))
+	assert.True(t, next.called)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/go-logr/logr"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
@@ -12,31 +13,39 @@ import (
 	"github.com/epam/edp-jenkins-operator/v2/pkg/util/consts"
 )
 
+// DeleteCDStageDeploy deletes the CDStageDeploy of the finished deployment and marks the deployment as cleaned up.
 type DeleteCDStageDeploy struct {
 	client client.Client
 	log    logr.Logger
 }
 
 func (h DeleteCDStageDeploy) ServeRequest(jenkinsDeploy *jenkinsApi.CDStageJenkinsDeployment) error {
-	log := h.log.WithValues("name", jenkinsDeploy.Spec.Job)
+	log := h.log.WithValues("name", jenkinsDeploy.GetStageJob().Name)
 	log.Info("deleting CDStageDeploy")
 
-	if err := h.deleteCDStageDeploy(jenkinsDeploy); err != nil {
+	if err := deleteCDStageDeploy(h.client, jenkinsDeploy); err != nil {
 		return fmt.Errorf("failed to delete CD stage deploy: %w", err)
 	}
 
+	jenkinsDeploy.Status.CleanupDone = true
+
 	log.Info("CDStageDeploy has been deleted")
 
 	return nil
 }
 
-func (h DeleteCDStageDeploy) deleteCDStageDeploy(jenkinsDeploy *jenkinsApi.CDStageJenkinsDeployment) error {
-	s, err := helper.GetCDStageDeploy(h.client, jenkinsDeploy.Labels[consts.CdStageDeployKey], jenkinsDeploy.Namespace)
+// deleteCDStageDeploy deletes the CDStageDeploy of the deployment. A CDStageDeploy which is already deleted is ignored.
+func deleteCDStageDeploy(k8sClient client.Client, jenkinsDeploy *jenkinsApi.CDStageJenkinsDeployment) error {
+	s, err := helper.GetCDStageDeploy(k8sClient, jenkinsDeploy.Labels[consts.CdStageDeployKey], jenkinsDeploy.Namespace)
 	if err != nil {
+		if k8sErrors.IsNotFound(err) {
+			return nil
+		}
+
 		return fmt.Errorf("failed to get CD stage deploy: %w", err)
 	}
 
-	if err := h.client.Delete(context.TODO(), s); err != nil {
+	if err := k8sClient.Delete(context.TODO(), s); err != nil && !k8sErrors.IsNotFound(err) {
 		return fmt.Errorf("failed to delete CD stage deploy: %w", err)
 	}
 
@@ -0,0 +1,40 @@
+package chain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	codebaseApi "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1"
+
+	"github.com/epam/edp-jenkins-operator/v2/pkg/util/consts"
+)
+
+func TestDeleteCDStageDeploy_ServeRequest(t *testing.T) {
+	jd := testDeployment()
+	jd.Labels[consts.CdStageDeployKey] = "stage-deploy"
+
+	cl := newQueueClient(t, &codebaseApi.CDStageDeploy{
+		ObjectMeta: metav1.ObjectMeta{Name: "stage-deploy", Namespace: "ns"},
+	})
+
+	h := DeleteCDStageDeploy{client: cl, log: logr.Discard()}
+
+	require.NoError(t, h.ServeRequest(jd))
+	assert.True(t, jd.Status.CleanupDone)
+
+	err := cl.Get(context.Background(), types.NamespacedName{Namespace: "ns", Name: "stage-deploy"}, &codebaseApi.CDStageDeploy{})
+	assert.True(t, k8sErrors.IsNotFound(err))
+
+	// the CDStageDeploy deleted by the previous attempt is ignored.
+	jd.Status.CleanupDone = false
+
+	require.NoError(t, h.ServeRequest(jd))
+	assert.True(t, jd.Status.CleanupDone)
+}
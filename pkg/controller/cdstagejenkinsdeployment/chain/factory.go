@@ -2,25 +2,46 @@ package chain
 
 import (
 	"fmt"
+	"time"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+	jenkinsClient "github.com/epam/edp-jenkins-operator/v2/pkg/client/jenkins"
 	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/cdstagejenkinsdeployment/chain/handler"
 	ps "github.com/epam/edp-jenkins-operator/v2/pkg/service/platform"
 )
 
 func CreateDefChain(k8sClient client.Client, service ps.PlatformService) handler.CDStageJenkinsDeploymentHandler {
 	log := ctrl.Log.WithName("cd-stage-jenkins-deployment-chain")
+	clientFactory := jenkinsClient.MakeClientBuilder(service, k8sClient)
 
-	return TriggerJenkinsDeployJob{
-		client:   k8sClient,
-		platform: service,
-		log:      log,
-		next: DeleteCDStageDeploy{
+	return QueueStageDeployment{
+		client: k8sClient,
+		log:    log,
+		next: CheckDeploymentGates{
 			client: k8sClient,
 			log:    log,
+			now:    time.Now,
+			next: TriggerJenkinsDeployJob{
+				client:               k8sClient,
+				jenkinsClientFactory: clientFactory,
+				log:                  log,
+				next: WaitForDeployBuild{
+					jenkinsClientFactory: clientFactory,
+					log:                  log,
+					next: RollbackOnFailure{
+						client:               k8sClient,
+						jenkinsClientFactory: clientFactory,
+						log:                  log,
+						next: DeleteCDStageDeploy{
+							client: k8sClient,
+							log:    log,
+						},
+					},
+				},
+			},
 		},
 	}
 }
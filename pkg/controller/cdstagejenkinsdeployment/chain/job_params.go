@@ -0,0 +1,92 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+)
+
+const (
+	autoDeployParam      = "AUTODEPLOY"
+	codebaseVersionParam = "CODEBASE_VERSION"
+)
+
+// buildJobParams returns parameters of the job which deploys the tags.
+// Params of the job override Params of the deployment which override the default parameters.
+func buildJobParams(
+	k8sClient client.Client,
+	jenkinsDeploy *jenkinsApi.CDStageJenkinsDeployment,
+	job *jenkinsApi.DeployJob,
+	tags []jenkinsApi.Tag,
+) (map[string]string, error) {
+	codebaseTags, err := json.Marshal(tags)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal codebaseTags to json: %w", err)
+	}
+
+	params := map[string]string{
+		autoDeployParam:      "true",
+		codebaseVersionParam: string(codebaseTags),
+	}
+
+	extraParams := make([]jenkinsApi.DeployJobParam, 0, len(jenkinsDeploy.Spec.Params)+len(job.Params))
+	extraParams = append(extraParams, jenkinsDeploy.Spec.Params...)
+	extraParams = append(extraParams, job.Params...)
+
+	for i := range extraParams {
+		value, err := getParamValue(k8sClient, jenkinsDeploy.Namespace, &extraParams[i])
+		if err != nil {
+			return nil, fmt.Errorf("failed to get value of parameter %s: %w", extraParams[i].Name, err)
+		}
+
+		params[extraParams[i].Name] = value
+	}
+
+	return params, nil
+}
+
+func getParamValue(k8sClient client.Client, namespace string, param *jenkinsApi.DeployJobParam) (string, error) {
+	if param.ValueFrom == nil {
+		return param.Value, nil
+	}
+
+	if ref := param.ValueFrom.SecretKeyRef; ref != nil {
+		secret := &corev1.Secret{}
+		if err := k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: ref.Name}, secret); err != nil {
+			return "", fmt.Errorf("failed to get secret %s: %w", ref.Name, err)
+		}
+
+		value, ok := secret.Data[ref.Key]
+		if !ok && !isOptional(ref.Optional) {
+			return "", fmt.Errorf("key %s is not found in secret %s", ref.Key, ref.Name)
+		}
+
+		return string(value), nil
+	}
+
+	if ref := param.ValueFrom.ConfigMapKeyRef; ref != nil {
+		cm := &corev1.ConfigMap{}
+		if err := k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: ref.Name}, cm); err != nil {
+			return "", fmt.Errorf("failed to get config map %s: %w", ref.Name, err)
+		}
+
+		value, ok := cm.Data[ref.Key]
+		if !ok && !isOptional(ref.Optional) {
+			return "", fmt.Errorf("key %s is not found in config map %s", ref.Key, ref.Name)
+		}
+
+		return value, nil
+	}
+
+	return param.Value, nil
+}
+
+func isOptional(optional *bool) bool {
+	return optional != nil && *optional
+}
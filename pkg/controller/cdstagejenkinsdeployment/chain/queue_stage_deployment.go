@@ -0,0 +1,119 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/cdstagejenkinsdeployment/chain/handler"
+)
+
+// QueueStageDeployment serialises deployments of the same stage: the deploy job is triggered
+// only when there are no other unfinished deployments of the stage ahead of this one.
+type QueueStageDeployment struct {
+	next   handler.CDStageJenkinsDeploymentHandler
+	client client.Client
+	log    logr.Logger
+}
+
+func (h QueueStageDeployment) ServeRequest(jenkinsDeploy *jenkinsApi.CDStageJenkinsDeployment) error {
+	if jenkinsDeploy.IsStarted() {
+		return nextServeOrNil(h.next, jenkinsDeploy)
+	}
+
+	log := h.log.WithValues("job", jenkinsDeploy.GetStageJob().Name)
+
+	started, pending, err := h.getStageDeployments(jenkinsDeploy)
+	if err != nil {
+		return err
+	}
+
+	if jenkinsDeploy.GetConcurrencyPolicy() == jenkinsApi.ConcurrencyPolicySupersede {
+		for i := range pending {
+			if err = h.supersede(&pending[i], jenkinsDeploy); err != nil {
+				return err
+			}
+		}
+
+		pending = nil
+	}
+
+	position := len(started) + len(pending)
+	jenkinsDeploy.Status.QueuePosition = position
+
+	if position > 0 {
+		jenkinsDeploy.Status.Status = jenkinsApi.CDStageJenkinsDeploymentStatusQueued
+		jenkinsDeploy.Status.Message = fmt.Sprintf("waiting for %d deployment(s) of the stage", position)
+
+		log.Info("deployment is queued", "position", position)
+
+		return nil
+	}
+
+	return nextServeOrNil(h.next, jenkinsDeploy)
+}
+
+// getStageDeployments returns unfinished deployments of the same stage which are ahead of the given one:
+// deployments which have already been started and older deployments which are not started yet.
+func (h QueueStageDeployment) getStageDeployments(
+	jenkinsDeploy *jenkinsApi.CDStageJenkinsDeployment,
+) (started, pending []jenkinsApi.CDStageJenkinsDeployment, err error) {
+	list := &jenkinsApi.CDStageJenkinsDeploymentList{}
+	if err = h.client.List(context.TODO(), list, client.InNamespace(jenkinsDeploy.Namespace)); err != nil {
+		return nil, nil, fmt.Errorf("failed to list CDStageJenkinsDeployments: %w", err)
+	}
+
+	for i := range list.Items {
+		d := list.Items[i]
+
+		if d.Name == jenkinsDeploy.Name || d.GetStageJob().Name != jenkinsDeploy.GetStageJob().Name || d.IsCompleted() {
+			continue
+		}
+
+		if d.IsStarted() {
+			started = append(started, d)
+
+			continue
+		}
+
+		if isCreatedBefore(&d, jenkinsDeploy) {
+			pending = append(pending, d)
+		}
+	}
+
+	sort.Slice(pending, func(i, j int) bool {
+		return isCreatedBefore(&pending[i], &pending[j])
+	})
+
+	return started, pending, nil
+}
+
+func (h QueueStageDeployment) supersede(old, jenkinsDeploy *jenkinsApi.CDStageJenkinsDeployment) error {
+	old.Status.Status = jenkinsApi.CDStageJenkinsDeploymentStatusSuperseded
+	old.Status.Message = fmt.Sprintf("superseded by %s", jenkinsDeploy.Name)
+	old.Status.QueuePosition = 0
+
+	if err := h.client.Status().Update(context.TODO(), old); err != nil {
+		return fmt.Errorf("failed to update status of superseded CDStageJenkinsDeployment %s: %w", old.Name, err)
+	}
+
+	if err := deleteCDStageDeploy(h.client, old); err != nil {
+		return fmt.Errorf("failed to complete superseded CDStageJenkinsDeployment %s: %w", old.Name, err)
+	}
+
+	h.log.Info("deployment has been superseded", "name", old.Name, "by", jenkinsDeploy.Name)
+
+	return nil
+}
+
+func isCreatedBefore(a, b *jenkinsApi.CDStageJenkinsDeployment) bool {
+	if a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.Name < b.Name
+	}
+
+	return a.CreationTimestamp.Before(&b.CreationTimestamp)
+}
@@ -0,0 +1,105 @@
+package chain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	codebaseApi "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1"
+
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/util/consts"
+)
+
+func stageDeployment(name string, created time.Time, status jenkinsApi.CDStageJenkinsDeploymentStatus) *jenkinsApi.CDStageJenkinsDeployment {
+	return &jenkinsApi.CDStageJenkinsDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "ns",
+			CreationTimestamp: metav1.NewTime(created),
+			Labels:            map[string]string{consts.CdStageDeployKey: name},
+		},
+		Spec:   jenkinsApi.CDStageJenkinsDeploymentSpec{Job: testJob},
+		Status: status,
+	}
+}
+
+func newQueueClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+
+	s := runtime.NewScheme()
+	require.NoError(t, jenkinsApi.AddToScheme(s))
+	s.AddKnownTypes(codebaseApi.SchemeGroupVersion, &codebaseApi.CDStageDeploy{})
+
+	return fake.NewClientBuilder().WithScheme(s).WithObjects(objs...).Build()
+}
+
+func TestQueueStageDeployment_ServeRequest_Queued(t *testing.T) {
+	now := time.Now()
+	running := stageDeployment("running", now.Add(-2*time.Minute),
+		jenkinsApi.CDStageJenkinsDeploymentStatus{
+			Status: jenkinsApi.CDStageJenkinsDeploymentStatusRunning,
+			Jobs:   []jenkinsApi.DeployJobStatus{runningJob(testJob, 1)},
+		})
+	pending := stageDeployment("pending", now.Add(-time.Minute), jenkinsApi.CDStageJenkinsDeploymentStatus{})
+	finished := stageDeployment("finished", now.Add(-3*time.Minute),
+		jenkinsApi.CDStageJenkinsDeploymentStatus{
+			Jobs:        []jenkinsApi.DeployJobStatus{runningJob(testJob, 1)},
+			BuildResult: "SUCCESS",
+			CleanupDone: true,
+		})
+	jd := stageDeployment("new", now, jenkinsApi.CDStageJenkinsDeploymentStatus{})
+
+	next := &nextHandlerMock{}
+	h := QueueStageDeployment{client: newQueueClient(t, running, pending, finished, jd), log: logr.Discard(), next: next}
+
+	require.NoError(t, h.ServeRequest(jd))
+	assert.False(t, next.called)
+	assert.Equal(t, jenkinsApi.CDStageJenkinsDeploymentStatusQueued, jd.Status.Status)
+	assert.Equal(t, 2, jd.Status.QueuePosition)
+}
+
+func TestQueueStageDeployment_ServeRequest_Supersede(t *testing.T) {
+	now := time.Now()
+	pending := stageDeployment("pending", now.Add(-time.Minute), jenkinsApi.CDStageJenkinsDeploymentStatus{})
+	pendingStageDeploy := &codebaseApi.CDStageDeploy{ObjectMeta: metav1.ObjectMeta{Name: "pending", Namespace: "ns"}}
+	jd := stageDeployment("new", now, jenkinsApi.CDStageJenkinsDeploymentStatus{})
+	jd.Spec.ConcurrencyPolicy = jenkinsApi.ConcurrencyPolicySupersede
+
+	cl := newQueueClient(t, pending, pendingStageDeploy, jd)
+	next := &nextHandlerMock{}
+	h := QueueStageDeployment{client: cl, log: logr.Discard(), next: next}
+
+	require.NoError(t, h.ServeRequest(jd))
+	assert.True(t, next.called)
+	assert.Zero(t, jd.Status.QueuePosition)
+
+	superseded := &jenkinsApi.CDStageJenkinsDeployment{}
+	require.NoError(t, cl.Get(context.Background(), types.NamespacedName{Namespace: "ns", Name: "pending"}, superseded))
+	assert.Equal(t, jenkinsApi.CDStageJenkinsDeploymentStatusSuperseded, superseded.Status.Status)
+
+	err := cl.Get(context.Background(), types.NamespacedName{Namespace: "ns", Name: "pending"}, &codebaseApi.CDStageDeploy{})
+	assert.True(t, k8sErrors.IsNotFound(err))
+}
+
+func TestQueueStageDeployment_ServeRequest_Started(t *testing.T) {
+	jd := stageDeployment("new", time.Now(), jenkinsApi.CDStageJenkinsDeploymentStatus{
+		Jobs: []jenkinsApi.DeployJobStatus{runningJob(testJob, 1)},
+	})
+
+	next := &nextHandlerMock{}
+	h := QueueStageDeployment{client: newQueueClient(t), log: logr.Discard(), next: next}
+
+	require.NoError(t, h.ServeRequest(jd))
+	assert.True(t, next.called)
+}
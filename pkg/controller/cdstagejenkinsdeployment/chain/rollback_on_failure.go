@@ -0,0 +1,239 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+
+	"github.com/bndr/gojenkins"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+	jenkinsClient "github.com/epam/edp-jenkins-operator/v2/pkg/client/jenkins"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/cdstagejenkinsdeployment/chain/handler"
+)
+
+// LastSuccessfulTagsConfigMap is a name of the config map which keeps the last successfully deployed tags of each stage.
+const LastSuccessfulTagsConfigMap = "cd-stage-jenkins-deployment-last-successful-tags"
+
+var configMapKeyInvalidChars = regexp.MustCompile(`[^-._a-zA-Z0-9]`)
+
+// RollbackOnFailure records the tags of successful deployments as the last known-good tag set of the stage
+// and, if the rollback policy allows it, redeploys that tag set when the deploy build fails.
+// The request is passed further once the deployment and its rollback are finished.
+type RollbackOnFailure struct {
+	next                 handler.CDStageJenkinsDeploymentHandler
+	client               client.Client
+	jenkinsClientFactory jenkinsClient.ClientFactory
+	log                  logr.Logger
+}
+
+func (h RollbackOnFailure) ServeRequest(jenkinsDeploy *jenkinsApi.CDStageJenkinsDeployment) error {
+	if jenkinsDeploy.Status.Status == jenkinsApi.CDStageJenkinsDeploymentStatusSucceeded {
+		if err := h.saveLastSuccessfulTags(jenkinsDeploy); err != nil {
+			return err
+		}
+
+		return nextServeOrNil(h.next, jenkinsDeploy)
+	}
+
+	if jenkinsDeploy.GetRollbackPolicy() == jenkinsApi.RollbackPolicyOnFailure {
+		if err := h.rollback(jenkinsDeploy); err != nil {
+			return err
+		}
+
+		if jenkinsDeploy.IsRollbackInProgress() {
+			return nil
+		}
+	}
+
+	return nextServeOrNil(h.next, jenkinsDeploy)
+}
+
+// rollback triggers the rollback of the failed deployment or follows the rollback build which is in progress.
+func (h RollbackOnFailure) rollback(jenkinsDeploy *jenkinsApi.CDStageJenkinsDeployment) error {
+	if jenkinsDeploy.Status.Rollback == nil {
+		return h.triggerRollback(jenkinsDeploy)
+	}
+
+	if jenkinsDeploy.IsRollbackInProgress() {
+		return h.waitForRollback(jenkinsDeploy)
+	}
+
+	return nil
+}
+
+func (h RollbackOnFailure) triggerRollback(jenkinsDeploy *jenkinsApi.CDStageJenkinsDeployment) error {
+	job := jenkinsDeploy.GetStageJob()
+	log := h.log.WithValues("job", job.Name)
+
+	tags, err := h.getLastSuccessfulTags(jenkinsDeploy)
+	if err != nil {
+		return err
+	}
+
+	if tags == nil || reflect.DeepEqual(tags, jenkinsDeploy.Spec.Tags) {
+		jenkinsDeploy.Status.Rollback = &jenkinsApi.CDStageJenkinsDeploymentRollback{
+			Status:  jenkinsApi.RollbackStatusSkipped,
+			Message: "there is no known-good tag set to roll back to",
+		}
+
+		log.Info("rollback has been skipped")
+
+		return nil
+	}
+
+	jc, err := newJenkinsClient(h.jenkinsClientFactory, jenkinsDeploy)
+	if err != nil {
+		return err
+	}
+
+	params, err := buildJobParams(h.client, jenkinsDeploy, &job, tags)
+	if err != nil {
+		return err
+	}
+
+	queueItem, err := jc.TriggerJob(job.Name, params)
+	if err != nil {
+		return fmt.Errorf("failed to trigger rollback: %w", err)
+	}
+
+	jenkinsDeploy.Status.Rollback = &jenkinsApi.CDStageJenkinsDeploymentRollback{
+		Status:      jenkinsApi.CDStageJenkinsDeploymentStatusRunning,
+		Tags:        tags,
+		DeployBuild: jenkinsApi.DeployBuild{QueueItem: queueItem},
+	}
+
+	log.Info("rollback has been triggered", "queueItem", queueItem)
+
+	return nil
+}
+
+func (h RollbackOnFailure) waitForRollback(jenkinsDeploy *jenkinsApi.CDStageJenkinsDeployment) error {
+	rollback := jenkinsDeploy.Status.Rollback
+	job := jenkinsDeploy.GetStageJob().Name
+	log := h.log.WithValues("job", job, "queueItem", rollback.QueueItem)
+
+	jc, err := newJenkinsClient(h.jenkinsClientFactory, jenkinsDeploy)
+	if err != nil {
+		return err
+	}
+
+	build, err := getTriggeredBuild(jc, job, &rollback.DeployBuild)
+	if err != nil {
+		return fmt.Errorf("failed to get rollback build: %w", err)
+	}
+
+	if build == nil {
+		log.Info("rollback build is waiting in the queue")
+
+		return nil
+	}
+
+	if !rollback.IsFinished() {
+		log.Info("rollback build is running", "build", rollback.BuildNumber)
+
+		return nil
+	}
+
+	if rollback.BuildResult != gojenkins.STATUS_SUCCESS {
+		rollback.Status = jenkinsApi.CDStageJenkinsDeploymentStatusFailed
+		rollback.Message = fmt.Sprintf("rollback build %s has finished with result %s", rollback.BuildURL, rollback.BuildResult)
+
+		log.Info("rollback build has failed", "build", rollback.BuildNumber, "result", rollback.BuildResult)
+
+		return nil
+	}
+
+	rollback.Status = jenkinsApi.CDStageJenkinsDeploymentStatusSucceeded
+	rollback.Message = ""
+
+	log.Info("rollback build has succeeded", "build", rollback.BuildNumber)
+
+	return nil
+}
+
+func (h RollbackOnFailure) getLastSuccessfulTags(jenkinsDeploy *jenkinsApi.CDStageJenkinsDeployment) ([]jenkinsApi.Tag, error) {
+	cm := &corev1.ConfigMap{}
+
+	err := h.client.Get(context.TODO(), types.NamespacedName{
+		Namespace: jenkinsDeploy.Namespace,
+		Name:      LastSuccessfulTagsConfigMap,
+	}, cm)
+	if err != nil {
+		if k8sErrors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to get config map %s: %w", LastSuccessfulTagsConfigMap, err)
+	}
+
+	data, ok := cm.Data[stageKey(jenkinsDeploy)]
+	if !ok {
+		return nil, nil
+	}
+
+	var tags []jenkinsApi.Tag
+	if err = json.Unmarshal([]byte(data), &tags); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal last successful tags: %w", err)
+	}
+
+	return tags, nil
+}
+
+func (h RollbackOnFailure) saveLastSuccessfulTags(jenkinsDeploy *jenkinsApi.CDStageJenkinsDeployment) error {
+	data, err := json.Marshal(jenkinsDeploy.Spec.Tags)
+	if err != nil {
+		return fmt.Errorf("failed to marshal codebaseTags to json: %w", err)
+	}
+
+	cm := &corev1.ConfigMap{}
+
+	err = h.client.Get(context.TODO(), types.NamespacedName{
+		Namespace: jenkinsDeploy.Namespace,
+		Name:      LastSuccessfulTagsConfigMap,
+	}, cm)
+	if err != nil {
+		if !k8sErrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get config map %s: %w", LastSuccessfulTagsConfigMap, err)
+		}
+
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      LastSuccessfulTagsConfigMap,
+				Namespace: jenkinsDeploy.Namespace,
+			},
+			Data: map[string]string{stageKey(jenkinsDeploy): string(data)},
+		}
+
+		if err = h.client.Create(context.TODO(), cm); err != nil {
+			return fmt.Errorf("failed to create config map %s: %w", LastSuccessfulTagsConfigMap, err)
+		}
+
+		return nil
+	}
+
+	if cm.Data == nil {
+		cm.Data = make(map[string]string)
+	}
+
+	cm.Data[stageKey(jenkinsDeploy)] = string(data)
+
+	if err = h.client.Update(context.TODO(), cm); err != nil {
+		return fmt.Errorf("failed to update config map %s: %w", LastSuccessfulTagsConfigMap, err)
+	}
+
+	return nil
+}
+
+// stageKey converts the deploy job path to a valid config map key.
+func stageKey(jenkinsDeploy *jenkinsApi.CDStageJenkinsDeployment) string {
+	return configMapKeyInvalidChars.ReplaceAllString(jenkinsDeploy.GetStageJob().Name, ".")
+}
@@ -0,0 +1,158 @@
+package chain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bndr/gojenkins"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	codebaseApi "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1"
+
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+	jenkinsClient "github.com/epam/edp-jenkins-operator/v2/pkg/client/jenkins"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/util/consts"
+)
+
+func newRollbackHandler(t *testing.T, jc *jenkinsClient.ClientMock, next *nextHandlerMock, objs ...client.Object) RollbackOnFailure {
+	t.Helper()
+
+	return RollbackOnFailure{
+		client:               newFakeClient(t, objs...),
+		jenkinsClientFactory: newClientFactory(jc),
+		log:                  logr.Discard(),
+		next:                 next,
+	}
+}
+
+func lastSuccessfulTags(data string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: LastSuccessfulTagsConfigMap, Namespace: "ns"},
+		Data:       map[string]string{"pipeline.job.stage": data},
+	}
+}
+
+func failedDeployment() *jenkinsApi.CDStageJenkinsDeployment {
+	jd := testDeployment()
+	jd.Spec.Tags = []jenkinsApi.Tag{{Codebase: "app", Tag: "2.0"}}
+	jd.Spec.RollbackPolicy = jenkinsApi.RollbackPolicyOnFailure
+	jd.Status.Status = jenkinsApi.CDStageJenkinsDeploymentStatusFailed
+	jd.Status.Jobs[0].BuildNumber = 7
+	jd.Status.Jobs[0].BuildResult = "FAILURE"
+	jd.Status.BuildResult = "FAILURE"
+
+	return jd
+}
+
+func TestRollbackOnFailure_ServeRequest_SavesSuccessfulTags(t *testing.T) {
+	jd := testDeployment()
+	jd.Spec.Tags = []jenkinsApi.Tag{{Codebase: "app", Tag: "1.0"}}
+	jd.Status.Status = jenkinsApi.CDStageJenkinsDeploymentStatusSucceeded
+
+	next := &nextHandlerMock{}
+	h := newRollbackHandler(t, &jenkinsClient.ClientMock{}, next)
+
+	require.NoError(t, h.ServeRequest(jd))
+	assert.True(t, next.called)
+
+	cm := &corev1.ConfigMap{}
+	require.NoError(t, h.client.Get(context.Background(),
+		types.NamespacedName{Namespace: "ns", Name: LastSuccessfulTagsConfigMap}, cm))
+	assert.Equal(t, `[{"codebase":"app","tag":"1.0"}]`, cm.Data["pipeline.job.stage"])
+}
+
+func TestRollbackOnFailure_ServeRequest_TriggersRollback(t *testing.T) {
+	jc := &jenkinsClient.ClientMock{}
+	jc.On("TriggerJob", testJob, map[string]string{
+		autoDeployParam:      "true",
+		codebaseVersionParam: `[{"codebase":"app","tag":"1.0"}]`,
+	}).Return(int64(9), nil)
+
+	next := &nextHandlerMock{}
+	jd := failedDeployment()
+	h := newRollbackHandler(t, jc, next, lastSuccessfulTags(`[{"codebase":"app","tag":"1.0"}]`))
+
+	require.NoError(t, h.ServeRequest(jd))
+	assert.False(t, next.called)
+	require.NotNil(t, jd.Status.Rollback)
+	assert.Equal(t, jenkinsApi.CDStageJenkinsDeploymentStatusRunning, jd.Status.Rollback.Status)
+	assert.Equal(t, int64(9), jd.Status.Rollback.QueueItem)
+	assert.True(t, jd.IsRollbackInProgress())
+}
+
+func TestRollbackOnFailure_ServeRequest_Skipped(t *testing.T) {
+	jd := failedDeployment()
+	next := &nextHandlerMock{}
+	h := newRollbackHandler(t, &jenkinsClient.ClientMock{}, next)
+
+	require.NoError(t, h.ServeRequest(jd))
+	assert.True(t, next.called)
+	require.NotNil(t, jd.Status.Rollback)
+	assert.Equal(t, jenkinsApi.RollbackStatusSkipped, jd.Status.Rollback.Status)
+	assert.False(t, jd.IsRollbackInProgress())
+}
+
+func TestRollbackOnFailure_ServeRequest_RollbackSucceeded(t *testing.T) {
+	jc := &jenkinsClient.ClientMock{}
+	jc.On("GetQueuedBuildNumber", int64(9)).Return(int64(8), nil)
+	jc.On("GetBuild", testJob, int64(8)).Return(&gojenkins.Build{
+		Raw: &gojenkins.BuildResponse{Result: gojenkins.STATUS_SUCCESS, URL: "https://jenkins/job/8/"},
+	}, nil)
+
+	jd := failedDeployment()
+	jd.Status.Rollback = &jenkinsApi.CDStageJenkinsDeploymentRollback{
+		Status:      jenkinsApi.CDStageJenkinsDeploymentStatusRunning,
+		DeployBuild: jenkinsApi.DeployBuild{QueueItem: 9},
+	}
+
+	next := &nextHandlerMock{}
+	h := newRollbackHandler(t, jc, next)
+
+	require.NoError(t, h.ServeRequest(jd))
+	assert.True(t, next.called)
+	assert.Equal(t, jenkinsApi.CDStageJenkinsDeploymentStatusSucceeded, jd.Status.Rollback.Status)
+	assert.Equal(t, "https://jenkins/job/8/", jd.Status.Rollback.BuildURL)
+	assert.False(t, jd.IsRollbackInProgress())
+}
+
+func TestRollbackOnFailure_ServeRequest_PolicyNever(t *testing.T) {
+	jd := failedDeployment()
+	jd.Spec.RollbackPolicy = ""
+
+	next := &nextHandlerMock{}
+	h := newRollbackHandler(t, &jenkinsClient.ClientMock{}, next)
+
+	require.NoError(t, h.ServeRequest(jd))
+	assert.True(t, next.called)
+	assert.Nil(t, jd.Status.Rollback)
+}
+
+func TestRollbackOnFailure_ServeRequest_DeletesCDStageDeployAfterFailure(t *testing.T) {
+	jd := failedDeployment()
+	jd.Spec.RollbackPolicy = ""
+	jd.Labels[consts.CdStageDeployKey] = "stage-deploy"
+
+	cl := newQueueClient(t, &codebaseApi.CDStageDeploy{
+		ObjectMeta: metav1.ObjectMeta{Name: "stage-deploy", Namespace: "ns"},
+	})
+
+	h := RollbackOnFailure{
+		client:               cl,
+		jenkinsClientFactory: newClientFactory(&jenkinsClient.ClientMock{}),
+		log:                  logr.Discard(),
+		next:                 DeleteCDStageDeploy{client: cl, log: logr.Discard()},
+	}
+
+	require.NoError(t, h.ServeRequest(jd))
+	assert.True(t, jd.IsCompleted())
+
+	err := cl.Get(context.Background(), types.NamespacedName{Namespace: "ns", Name: "stage-deploy"}, &codebaseApi.CDStageDeploy{})
+	assert.True(t, k8sErrors.IsNotFound(err))
+}
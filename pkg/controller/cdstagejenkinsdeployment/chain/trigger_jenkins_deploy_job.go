@@ -1,75 +1,131 @@
 package chain
 
 import (
-	"encoding/json"
 	"fmt"
 
+	"github.com/bndr/gojenkins"
 	"github.com/go-logr/logr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
 	jenkinsClient "github.com/epam/edp-jenkins-operator/v2/pkg/client/jenkins"
 	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/cdstagejenkinsdeployment/chain/handler"
-	ps "github.com/epam/edp-jenkins-operator/v2/pkg/service/platform"
-	"github.com/epam/edp-jenkins-operator/v2/pkg/util/platform"
 )
 
+// TriggerJenkinsDeployJob triggers the jobs of the deployment which are ready to run:
+// all jobs at once in Parallel mode or the next job after the previous one has succeeded in Sequence mode.
 type TriggerJenkinsDeployJob struct {
-	next     handler.CDStageJenkinsDeploymentHandler
-	client   client.Client
-	platform ps.PlatformService
-	log      logr.Logger
+	next                 handler.CDStageJenkinsDeploymentHandler
+	client               client.Client
+	jenkinsClientFactory jenkinsClient.ClientFactory
+	log                  logr.Logger
 }
 
 const JenkinsKey = "jenkinsName"
 
 func (h TriggerJenkinsDeployJob) ServeRequest(jenkinsDeploy *jenkinsApi.CDStageJenkinsDeployment) error {
-	log := h.log.WithValues("job", jenkinsDeploy.Spec.Job)
-	log.Info("triggering deploy job.")
+	jobs := jenkinsDeploy.GetJobs()
+	syncJobStatuses(jenkinsDeploy, jobs)
 
-	jc, err := h.initJenkinsClient(jenkinsDeploy)
-	if err != nil {
-		return fmt.Errorf("failed to create jenkins client: %w", err)
+	toTrigger := jobsToTrigger(jenkinsDeploy)
+	if len(toTrigger) == 0 {
+		return nextServeOrNil(h.next, jenkinsDeploy)
 	}
 
-	codebaseTags, err := json.Marshal(jenkinsDeploy.Spec.Tags)
+	jc, err := newJenkinsClient(h.jenkinsClientFactory, jenkinsDeploy)
 	if err != nil {
-		return fmt.Errorf("failed to marshal codebaseTags to json: %w", err)
+		return err
 	}
 
-	jobParameters := map[string]string{
-		"AUTODEPLOY":       "true",
-		"CODEBASE_VERSION": string(codebaseTags),
-	}
+	for _, i := range toTrigger {
+		log := h.log.WithValues("job", jobs[i].Name)
+		log.Info("triggering deploy job.")
+
+		params, err := buildJobParams(h.client, jenkinsDeploy, &jobs[i], jenkinsDeploy.Spec.Tags)
+		if err != nil {
+			return err
+		}
+
+		queueItem, err := jc.TriggerJob(jobs[i].Name, params)
+		if err != nil {
+			return fmt.Errorf("failed to trigger job %s: %w", jobs[i].Name, err)
+		}
 
-	if err := jc.TriggerJob(jenkinsDeploy.Spec.Job, jobParameters); err != nil {
-		return fmt.Errorf("failed to trigger job: %w", err)
+		jenkinsDeploy.Status.Jobs[i].QueueItem = queueItem
+		jenkinsDeploy.Status.Jobs[i].Status = jenkinsApi.CDStageJenkinsDeploymentStatusRunning
+
+		log.Info("deploy job has been triggered.", "queueItem", queueItem)
 	}
 
-	log.Info("deploy job has been triggered.")
+	jenkinsDeploy.Status.Status = jenkinsApi.CDStageJenkinsDeploymentStatusRunning
+	jenkinsDeploy.Status.Message = ""
 
 	return nextServeOrNil(h.next, jenkinsDeploy)
 }
 
-func (h TriggerJenkinsDeployJob) initJenkinsClient(jenkinsDeploy *jenkinsApi.CDStageJenkinsDeployment) (*jenkinsClient.JenkinsClient, error) {
-	jenkinsInstance, err := h.getJenkins(jenkinsDeploy)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get jenkins: %w", err)
+// syncJobStatuses aligns the job statuses with the jobs of the deployment. The statuses are matched by the job name,
+// so the jobs which have already been triggered are not triggered again if the jobs are changed during the deployment.
+func syncJobStatuses(jenkinsDeploy *jenkinsApi.CDStageJenkinsDeployment, jobs []jenkinsApi.DeployJob) {
+	current := jenkinsDeploy.Status.Jobs
+	used := make([]bool, len(current))
+	statuses := make([]jenkinsApi.DeployJobStatus, len(jobs))
+
+	for i := range jobs {
+		statuses[i] = jenkinsApi.DeployJobStatus{
+			Name:   jobs[i].Name,
+			Status: jenkinsApi.DeployJobStatusPending,
+		}
+
+		for k := range current {
+			if !used[k] && current[k].Name == jobs[i].Name {
+				statuses[i] = current[k]
+				used[k] = true
+
+				break
+			}
+		}
 	}
 
-	jenkinsCl, err := jenkinsClient.InitGoJenkinsClient(jenkinsInstance, h.platform)
-	if err != nil {
-		return nil, fmt.Errorf("failed to init Jenkins Client: %w", err)
+	jenkinsDeploy.Status.Jobs = statuses
+}
+
+// jobsToTrigger returns indexes of the jobs which should be triggered now.
+func jobsToTrigger(jenkinsDeploy *jenkinsApi.CDStageJenkinsDeployment) []int {
+	var res []int
+
+	for i := range jenkinsDeploy.Status.Jobs {
+		st := &jenkinsDeploy.Status.Jobs[i]
+
+		if jenkinsDeploy.GetJobsExecution() == jenkinsApi.JobsExecutionParallel {
+			if !st.IsTriggered() {
+				res = append(res, i)
+			}
+
+			continue
+		}
+
+		if !st.IsTriggered() {
+			return []int{i}
+		}
+
+		if st.BuildResult != gojenkins.STATUS_SUCCESS {
+			return nil
+		}
 	}
 
-	return jenkinsCl, nil
+	return res
 }
 
-func (h TriggerJenkinsDeployJob) getJenkins(jenkinsDeploy *jenkinsApi.CDStageJenkinsDeployment) (*jenkinsApi.Jenkins, error) {
-	jenkinsInstance, err := platform.GetJenkinsInstance(h.client, jenkinsDeploy.Labels[JenkinsKey], jenkinsDeploy.Namespace)
+func newJenkinsClient(
+	factory jenkinsClient.ClientFactory,
+	jenkinsDeploy *jenkinsApi.CDStageJenkinsDeployment,
+) (jenkinsClient.ClientInterface, error) {
+	jenkinsName := jenkinsDeploy.Labels[JenkinsKey]
+
+	jc, err := factory.MakeNewClient(&jenkinsDeploy.ObjectMeta, &jenkinsName)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get jenkins instance: %w", err)
+		return nil, fmt.Errorf("failed to create jenkins client: %w", err)
 	}
 
-	return jenkinsInstance, nil
+	return jc, nil
 }
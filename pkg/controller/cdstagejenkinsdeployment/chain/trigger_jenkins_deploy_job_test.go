@@ -0,0 +1,161 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+	jenkinsClient "github.com/epam/edp-jenkins-operator/v2/pkg/client/jenkins"
+)
+
+func TestTriggerJenkinsDeployJob_ServeRequest(t *testing.T) {
+	jc := &jenkinsClient.ClientMock{}
+	jc.On("TriggerJob", testJob, map[string]string{
+		autoDeployParam:      "true",
+		codebaseVersionParam: "null",
+	}).Return(testQueueItem, nil)
+
+	next := &nextHandlerMock{}
+	jd := testDeployment()
+	jd.Status = jenkinsApi.CDStageJenkinsDeploymentStatus{}
+
+	h := TriggerJenkinsDeployJob{
+		client:               newFakeClient(t),
+		jenkinsClientFactory: newClientFactory(jc),
+		log:                  logr.Discard(),
+		next:                 next,
+	}
+
+	require.NoError(t, h.ServeRequest(jd))
+	assert.True(t, next.called)
+	require.Len(t, jd.Status.Jobs, 1)
+	assert.Equal(t, testQueueItem, jd.Status.Jobs[0].QueueItem)
+	assert.Equal(t, jenkinsApi.CDStageJenkinsDeploymentStatusRunning, jd.Status.Status)
+
+	next.called = false
+	require.NoError(t, h.ServeRequest(jd))
+	assert.True(t, next.called)
+	jc.AssertNumberOfCalls(t, "TriggerJob", 1)
+}
+
+func TestTriggerJenkinsDeployJob_ServeRequest_ParallelJobsWithParams(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "deploy-secret", Namespace: "ns"},
+		Data:       map[string][]byte{"token": []byte("secret-value")},
+	}
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "deploy-config", Namespace: "ns"},
+		Data:       map[string]string{"region": "eu"},
+	}
+
+	jc := &jenkinsClient.ClientMock{}
+	jc.On("TriggerJob", "deploy", map[string]string{
+		autoDeployParam:      "true",
+		codebaseVersionParam: "null",
+		"TOKEN":              "secret-value",
+		"REGION":             "eu",
+	}).Return(int64(1), nil)
+	jc.On("TriggerJob", "smoke-tests", map[string]string{
+		autoDeployParam:      "true",
+		codebaseVersionParam: "null",
+		"TOKEN":              "secret-value",
+		"REGION":             "us",
+	}).Return(int64(2), nil)
+
+	jd := testDeployment()
+	jd.Status = jenkinsApi.CDStageJenkinsDeploymentStatus{}
+	jd.Spec.JobsExecution = jenkinsApi.JobsExecutionParallel
+	jd.Spec.Params = []jenkinsApi.DeployJobParam{
+		{
+			Name: "TOKEN",
+			ValueFrom: &jenkinsApi.DeployJobParamSource{SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "deploy-secret"},
+				Key:                  "token",
+			}},
+		},
+		{
+			Name: "REGION",
+			ValueFrom: &jenkinsApi.DeployJobParamSource{ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "deploy-config"},
+				Key:                  "region",
+			}},
+		},
+	}
+	jd.Spec.Jobs = []jenkinsApi.DeployJob{
+		{Name: "deploy"},
+		{Name: "smoke-tests", Params: []jenkinsApi.DeployJobParam{{Name: "REGION", Value: "us"}}},
+	}
+
+	h := TriggerJenkinsDeployJob{
+		client:               newFakeClient(t, secret, cm),
+		jenkinsClientFactory: newClientFactory(jc),
+		log:                  logr.Discard(),
+		next:                 &nextHandlerMock{},
+	}
+
+	require.NoError(t, h.ServeRequest(jd))
+	require.Len(t, jd.Status.Jobs, 2)
+	assert.Equal(t, int64(1), jd.Status.Jobs[0].QueueItem)
+	assert.Equal(t, int64(2), jd.Status.Jobs[1].QueueItem)
+	assert.Equal(t, "deploy", jd.GetStageJob().Name)
+}
+
+func TestTriggerJenkinsDeployJob_ServeRequest_MissingSecretKey(t *testing.T) {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "deploy-secret", Namespace: "ns"}}
+
+	jd := testDeployment()
+	jd.Status = jenkinsApi.CDStageJenkinsDeploymentStatus{}
+	jd.Spec.Params = []jenkinsApi.DeployJobParam{{
+		Name: "TOKEN",
+		ValueFrom: &jenkinsApi.DeployJobParamSource{SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "deploy-secret"},
+			Key:                  "token",
+		}},
+	}}
+
+	h := TriggerJenkinsDeployJob{
+		client:               newFakeClient(t, secret),
+		jenkinsClientFactory: newClientFactory(&jenkinsClient.ClientMock{}),
+		log:                  logr.Discard(),
+	}
+
+	err := h.ServeRequest(jd)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "key token is not found in secret deploy-secret")
+}
+
+func TestTriggerJenkinsDeployJob_ServeRequest_JobsChanged(t *testing.T) {
+	jc := &jenkinsClient.ClientMock{}
+	jc.On("TriggerJob", "smoke-tests", map[string]string{
+		autoDeployParam:      "true",
+		codebaseVersionParam: "null",
+	}).Return(int64(5), nil)
+
+	jd := testDeployment()
+	jd.Spec.JobsExecution = jenkinsApi.JobsExecutionParallel
+	jd.Spec.Jobs = []jenkinsApi.DeployJob{{Name: testJob}, {Name: "smoke-tests"}}
+
+	h := TriggerJenkinsDeployJob{
+		client:               newFakeClient(t),
+		jenkinsClientFactory: newClientFactory(jc),
+		log:                  logr.Discard(),
+		next:                 &nextHandlerMock{},
+	}
+
+	require.NoError(t, h.ServeRequest(jd))
+	jc.AssertExpectations(t)
+	require.Len(t, jd.Status.Jobs, 2)
+	assert.Equal(t, runningJob(testJob, testQueueItem), jd.Status.Jobs[0])
+	assert.Equal(t, runningJob("smoke-tests", 5), jd.Status.Jobs[1])
+
+	jd.Spec.Jobs = []jenkinsApi.DeployJob{{Name: "smoke-tests"}}
+
+	require.NoError(t, h.ServeRequest(jd))
+	jc.AssertNumberOfCalls(t, "TriggerJob", 1)
+	assert.Equal(t, []jenkinsApi.DeployJobStatus{runningJob("smoke-tests", 5)}, jd.Status.Jobs)
+}
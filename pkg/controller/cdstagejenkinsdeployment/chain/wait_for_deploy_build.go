@@ -0,0 +1,178 @@
+package chain
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/bndr/gojenkins"
+	"github.com/go-logr/logr"
+
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+	jenkinsClient "github.com/epam/edp-jenkins-operator/v2/pkg/client/jenkins"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/cdstagejenkinsdeployment/chain/handler"
+)
+
+// WaitForDeployBuild follows the triggered builds of the deployment jobs and passes the request further
+// only when the deployment has finished. The result of the finished deployment is set again on every request,
+// so the status overwritten by a failed post-deployment step is restored when the step is retried.
+type WaitForDeployBuild struct {
+	next                 handler.CDStageJenkinsDeploymentHandler
+	jenkinsClientFactory jenkinsClient.ClientFactory
+	log                  logr.Logger
+}
+
+func (h WaitForDeployBuild) ServeRequest(jenkinsDeploy *jenkinsApi.CDStageJenkinsDeployment) error {
+	var jc jenkinsClient.ClientInterface
+
+	for i := range jenkinsDeploy.Status.Jobs {
+		st := &jenkinsDeploy.Status.Jobs[i]
+
+		if !st.IsTriggered() || st.IsFinished() {
+			continue
+		}
+
+		if jc == nil {
+			var err error
+
+			if jc, err = newJenkinsClient(h.jenkinsClientFactory, jenkinsDeploy); err != nil {
+				return err
+			}
+		}
+
+		if err := h.followBuild(jc, st); err != nil {
+			return err
+		}
+	}
+
+	return h.setDeploymentResult(jenkinsDeploy)
+}
+
+func (h WaitForDeployBuild) followBuild(jc jenkinsClient.ClientInterface, st *jenkinsApi.DeployJobStatus) error {
+	log := h.log.WithValues("job", st.Name, "queueItem", st.QueueItem)
+
+	build, err := getTriggeredBuild(jc, st.Name, &st.DeployBuild)
+	if errors.Is(err, jenkinsClient.ErrQueueItemCancelled) || errors.Is(err, jenkinsClient.ErrQueueItemNotFound) {
+		// no build will be started from the queue item, the job fails instead of waiting for it.
+		st.Status = jenkinsApi.CDStageJenkinsDeploymentStatusFailed
+		st.BuildResult = gojenkins.STATUS_ABORTED
+		st.Message = notStartedMessage(err)
+
+		log.Info("deploy build has not been started", "reason", st.Message)
+
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to get deploy build of job %s: %w", st.Name, err)
+	}
+
+	if build == nil {
+		log.Info("deploy build is waiting in the queue")
+
+		return nil
+	}
+
+	if !st.IsFinished() {
+		log.Info("deploy build is running", "build", st.BuildNumber)
+
+		return nil
+	}
+
+	if st.BuildResult != gojenkins.STATUS_SUCCESS {
+		st.Status = jenkinsApi.CDStageJenkinsDeploymentStatusFailed
+
+		log.Info("deploy build has failed", "build", st.BuildNumber, "result", st.BuildResult)
+
+		return nil
+	}
+
+	st.Status = jenkinsApi.CDStageJenkinsDeploymentStatusSucceeded
+
+	log.Info("deploy build has succeeded", "build", st.BuildNumber)
+
+	return nil
+}
+
+// setDeploymentResult sets the result of the deployment once all its builds are finished.
+// The deployment succeeds if all jobs succeed and fails if any job fails.
+func (h WaitForDeployBuild) setDeploymentResult(jenkinsDeploy *jenkinsApi.CDStageJenkinsDeployment) error {
+	var failedJob *jenkinsApi.DeployJobStatus
+
+	succeeded := 0
+
+	for i := range jenkinsDeploy.Status.Jobs {
+		st := &jenkinsDeploy.Status.Jobs[i]
+
+		if st.IsTriggered() && !st.IsFinished() {
+			return nil
+		}
+
+		if st.BuildResult == gojenkins.STATUS_SUCCESS {
+			succeeded++
+		} else if st.IsFinished() && failedJob == nil {
+			failedJob = st
+		}
+	}
+
+	if failedJob != nil {
+		jenkinsDeploy.Status.Status = jenkinsApi.CDStageJenkinsDeploymentStatusFailed
+		jenkinsDeploy.Status.BuildResult = failedJob.BuildResult
+		jenkinsDeploy.Status.Message = fmt.Sprintf("deploy build %s has finished with result %s",
+			failedJob.BuildURL, failedJob.BuildResult)
+
+		if failedJob.BuildNumber == 0 {
+			jenkinsDeploy.Status.Message = fmt.Sprintf("deploy build of job %s has not been started: %s",
+				failedJob.Name, failedJob.Message)
+		}
+
+		return nextServeOrNil(h.next, jenkinsDeploy)
+	}
+
+	if succeeded < len(jenkinsDeploy.Status.Jobs) {
+		return nil
+	}
+
+	jenkinsDeploy.Status.Status = jenkinsApi.CDStageJenkinsDeploymentStatusSucceeded
+	jenkinsDeploy.Status.BuildResult = gojenkins.STATUS_SUCCESS
+	jenkinsDeploy.Status.Message = ""
+
+	return nextServeOrNil(h.next, jenkinsDeploy)
+}
+
+// getTriggeredBuild returns the build started from the queue item and updates the build state.
+// Nil is returned if the build is still waiting in the queue.
+func getTriggeredBuild(jc jenkinsClient.ClientInterface, job string, state *jenkinsApi.DeployBuild) (*gojenkins.Build, error) {
+	if state.BuildNumber == 0 {
+		n, err := jc.GetQueuedBuildNumber(state.QueueItem)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get build number: %w", err)
+		}
+
+		if n == 0 {
+			return nil, nil
+		}
+
+		state.BuildNumber = n
+	}
+
+	build, err := jc.GetBuild(job, state.BuildNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get build: %w", err)
+	}
+
+	state.BuildURL = build.GetUrl()
+
+	if !build.Raw.Building {
+		state.BuildResult = build.GetResult()
+	}
+
+	return build, nil
+}
+
+func notStartedMessage(err error) string {
+	if errors.Is(err, jenkinsClient.ErrQueueItemCancelled) {
+		return "the queue item has been cancelled"
+	}
+
+	return "the queue item has expired"
+}
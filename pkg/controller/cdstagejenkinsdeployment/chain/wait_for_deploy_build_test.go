@@ -0,0 +1,225 @@
+package chain
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/bndr/gojenkins"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+	jenkinsClient "github.com/epam/edp-jenkins-operator/v2/pkg/client/jenkins"
+)
+
+const (
+	testJob       = "pipeline/job/stage"
+	testQueueItem = int64(3)
+)
+
+type nextHandlerMock struct {
+	called bool
+}
+
+func (n *nextHandlerMock) ServeRequest(*jenkinsApi.CDStageJenkinsDeployment) error {
+	n.called = true
+
+	return nil
+}
+
+func runningJob(name string, queueItem int64) jenkinsApi.DeployJobStatus {
+	return jenkinsApi.DeployJobStatus{
+		Name:        name,
+		Status:      jenkinsApi.CDStageJenkinsDeploymentStatusRunning,
+		DeployBuild: jenkinsApi.DeployBuild{QueueItem: queueItem},
+	}
+}
+
+func testDeployment() *jenkinsApi.CDStageJenkinsDeployment {
+	return &jenkinsApi.CDStageJenkinsDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "deploy",
+			Namespace: "ns",
+			Labels:    map[string]string{JenkinsKey: "jenkins"},
+		},
+		Spec: jenkinsApi.CDStageJenkinsDeploymentSpec{Job: testJob},
+		Status: jenkinsApi.CDStageJenkinsDeploymentStatus{
+			Status: jenkinsApi.CDStageJenkinsDeploymentStatusRunning,
+			Jobs:   []jenkinsApi.DeployJobStatus{runningJob(testJob, testQueueItem)},
+		},
+	}
+}
+
+func newClientFactory(jc *jenkinsClient.ClientMock) *jenkinsClient.ClientBuilderMock {
+	factory := &jenkinsClient.ClientBuilderMock{}
+	factory.On("MakeNewClient", mock.Anything).Return(jc, nil)
+
+	return factory
+}
+
+func newFakeClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+
+	s := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(s))
+
+	return fake.NewClientBuilder().WithScheme(s).WithObjects(objs...).Build()
+}
+
+func newWaitHandler(jc *jenkinsClient.ClientMock, next *nextHandlerMock) WaitForDeployBuild {
+	return WaitForDeployBuild{
+		jenkinsClientFactory: newClientFactory(jc),
+		log:                  logr.Discard(),
+		next:                 next,
+	}
+}
+
+func TestWaitForDeployBuild_ServeRequest_Queued(t *testing.T) {
+	jc := &jenkinsClient.ClientMock{}
+	jc.On("GetQueuedBuildNumber", testQueueItem).Return(int64(0), nil)
+
+	next := &nextHandlerMock{}
+	jd := testDeployment()
+
+	require.NoError(t, newWaitHandler(jc, next).ServeRequest(jd))
+	assert.False(t, next.called)
+	assert.Equal(t, jenkinsApi.CDStageJenkinsDeploymentStatusRunning, jd.Status.Status)
+	assert.Zero(t, jd.Status.Jobs[0].BuildNumber)
+}
+
+func TestWaitForDeployBuild_ServeRequest_Running(t *testing.T) {
+	jc := &jenkinsClient.ClientMock{}
+	jc.On("GetQueuedBuildNumber", testQueueItem).Return(int64(7), nil)
+	jc.On("GetBuild", testJob, int64(7)).Return(&gojenkins.Build{
+		Raw: &gojenkins.BuildResponse{Building: true, URL: "https://jenkins/job/7/"},
+	}, nil)
+
+	next := &nextHandlerMock{}
+	jd := testDeployment()
+
+	require.NoError(t, newWaitHandler(jc, next).ServeRequest(jd))
+	assert.False(t, next.called)
+	assert.Equal(t, int64(7), jd.Status.Jobs[0].BuildNumber)
+	assert.Equal(t, "https://jenkins/job/7/", jd.Status.Jobs[0].BuildURL)
+	assert.Empty(t, jd.Status.BuildResult)
+}
+
+func TestWaitForDeployBuild_ServeRequest_Succeeded(t *testing.T) {
+	jc := &jenkinsClient.ClientMock{}
+	jc.On("GetBuild", testJob, int64(7)).Return(&gojenkins.Build{
+		Raw: &gojenkins.BuildResponse{Result: gojenkins.STATUS_SUCCESS, URL: "https://jenkins/job/7/"},
+	}, nil)
+
+	next := &nextHandlerMock{}
+	jd := testDeployment()
+	jd.Status.Jobs[0].BuildNumber = 7
+
+	require.NoError(t, newWaitHandler(jc, next).ServeRequest(jd))
+	assert.True(t, next.called)
+	assert.Equal(t, jenkinsApi.CDStageJenkinsDeploymentStatusSucceeded, jd.Status.Status)
+	assert.Equal(t, jenkinsApi.CDStageJenkinsDeploymentStatusSucceeded, jd.Status.Jobs[0].Status)
+	assert.Equal(t, gojenkins.STATUS_SUCCESS, jd.Status.BuildResult)
+}
+
+func TestWaitForDeployBuild_ServeRequest_Failed(t *testing.T) {
+	jc := &jenkinsClient.ClientMock{}
+	jc.On("GetBuild", testJob, int64(7)).Return(&gojenkins.Build{
+		Raw: &gojenkins.BuildResponse{Result: "FAILURE", URL: "https://jenkins/job/7/"},
+	}, nil)
+
+	next := &nextHandlerMock{}
+	jd := testDeployment()
+	jd.Status.Jobs[0].BuildNumber = 7
+
+	require.NoError(t, newWaitHandler(jc, next).ServeRequest(jd))
+	assert.True(t, next.called)
+	assert.Equal(t, jenkinsApi.CDStageJenkinsDeploymentStatusFailed, jd.Status.Status)
+	assert.Equal(t, "FAILURE", jd.Status.BuildResult)
+	assert.Contains(t, jd.Status.Message, "https://jenkins/job/7/")
+}
+
+func TestWaitForDeployBuild_ServeRequest_QueueItemCancelled(t *testing.T) {
+	jc := &jenkinsClient.ClientMock{}
+	jc.On("GetQueuedBuildNumber", testQueueItem).
+		Return(int64(0), fmt.Errorf("failed to get queue item: %w", jenkinsClient.ErrQueueItemCancelled))
+
+	next := &nextHandlerMock{}
+	jd := testDeployment()
+
+	require.NoError(t, newWaitHandler(jc, next).ServeRequest(jd))
+	assert.True(t, next.called)
+	assert.Equal(t, jenkinsApi.CDStageJenkinsDeploymentStatusFailed, jd.Status.Status)
+	assert.Equal(t, jenkinsApi.CDStageJenkinsDeploymentStatusFailed, jd.Status.Jobs[0].Status)
+	assert.Equal(t, gojenkins.STATUS_ABORTED, jd.Status.BuildResult)
+	assert.Equal(t, "deploy build of job "+testJob+" has not been started: the queue item has been cancelled",
+		jd.Status.Message)
+}
+
+func TestWaitForDeployBuild_ServeRequest_QueueItemExpired(t *testing.T) {
+	jc := &jenkinsClient.ClientMock{}
+	jc.On("GetQueuedBuildNumber", testQueueItem).
+		Return(int64(0), fmt.Errorf("failed to get queue item: %w", jenkinsClient.ErrQueueItemNotFound))
+
+	next := &nextHandlerMock{}
+	jd := testDeployment()
+
+	require.NoError(t, newWaitHandler(jc, next).ServeRequest(jd))
+	assert.True(t, next.called)
+	assert.Equal(t, jenkinsApi.CDStageJenkinsDeploymentStatusFailed, jd.Status.Status)
+	assert.Contains(t, jd.Status.Message, "the queue item has expired")
+}
+
+func TestWaitForDeployBuild_ServeRequest_SequenceNextJobPending(t *testing.T) {
+	jc := &jenkinsClient.ClientMock{}
+	jc.On("GetBuild", testJob, int64(7)).Return(&gojenkins.Build{
+		Raw: &gojenkins.BuildResponse{Result: gojenkins.STATUS_SUCCESS},
+	}, nil)
+
+	next := &nextHandlerMock{}
+	jd := testDeployment()
+	jd.Status.Jobs[0].BuildNumber = 7
+	jd.Status.Jobs = append(jd.Status.Jobs, jenkinsApi.DeployJobStatus{
+		Name:   "smoke-tests",
+		Status: jenkinsApi.DeployJobStatusPending,
+	})
+
+	require.NoError(t, newWaitHandler(jc, next).ServeRequest(jd))
+	assert.False(t, next.called)
+	assert.Empty(t, jd.Status.BuildResult)
+	assert.Equal(t, []int{1}, jobsToTrigger(jd))
+}
+
+func TestWaitForDeployBuild_ServeRequest_GetBuildErr(t *testing.T) {
+	jc := &jenkinsClient.ClientMock{}
+	jc.On("GetBuild", testJob, int64(7)).Return(nil, errors.New("fatal"))
+
+	jd := testDeployment()
+	jd.Status.Jobs[0].BuildNumber = 7
+
+	err := newWaitHandler(jc, &nextHandlerMock{}).ServeRequest(jd)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to get deploy build")
+}
+
+func TestWaitForDeployBuild_ServeRequest_RestoresFinishedResult(t *testing.T) {
+	next := &nextHandlerMock{}
+	jd := testDeployment()
+	jd.Status.Jobs[0].BuildNumber = 7
+	jd.Status.Jobs[0].BuildResult = gojenkins.STATUS_SUCCESS
+	jd.Status.Jobs[0].Status = jenkinsApi.CDStageJenkinsDeploymentStatusSucceeded
+	jd.Status.BuildResult = gojenkins.STATUS_SUCCESS
+	jd.SetFailedStatus(errors.New("failed to update config map"))
+
+	require.NoError(t, newWaitHandler(&jenkinsClient.ClientMock{}, next).ServeRequest(jd))
+	assert.True(t, next.called)
+	assert.Equal(t, jenkinsApi.CDStageJenkinsDeploymentStatusSucceeded, jd.Status.Status)
+	assert.Empty(t, jd.Status.Message)
+}
@@ -1,6 +1,7 @@
 package helper
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -8,9 +9,13 @@ import (
 	"regexp"
 	"strings"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
+	"github.com/epam/edp-jenkins-operator/v2/pkg/util/consts"
 	"github.com/epam/edp-jenkins-operator/v2/pkg/util/finalizer"
+	plutil "github.com/epam/edp-jenkins-operator/v2/pkg/util/platform"
 )
 
 const (
@@ -217,3 +222,89 @@ func TryToDelete(instance client.Object, finalizerName string, deleteFunc func()
 func JenkinsIsNotFoundErr(err error) bool {
 	return err.Error() == "404"
 }
+
+// UpdateKeepingStatus updates the instance and restores the status it had before the update.
+// The update response carries the stored status, the synced one is kept for the following status update.
+func UpdateKeepingStatus[T any, PT interface {
+	*T
+	DeepCopy() *T
+}](ctx context.Context, k8sClient client.Client, instance client.Object, status PT) error {
+	synced := status.DeepCopy()
+
+	if err := k8sClient.Update(ctx, instance); err != nil {
+		return fmt.Errorf("failed to update instance: %w", err)
+	}
+
+	*status = *synced
+
+	return nil
+}
+
+// SetFolderOrJenkinsOwner sets the JenkinsFolder with the given name or, without a folder, the Jenkins of the instance
+// as the owner of the instance and removes the references to the previous JenkinsFolder or Jenkins owner.
+// It returns the name of the Jenkins folder the instance belongs to.
+func SetFolderOrJenkinsOwner(k8sClient client.Client, instance client.Object, folderName, ownerName *string) (string, error) {
+	var (
+		owner     client.Object
+		ownerKind string
+		folder    string
+	)
+
+	if folderName != nil && *folderName != "" {
+		jf, err := plutil.GetJenkinsFolderInstance(k8sClient, *folderName, instance.GetNamespace())
+		if err != nil {
+			return "", fmt.Errorf("failed to get folder owner: %w", err)
+		}
+
+		if !jf.Status.Available {
+			return "", fmt.Errorf("jenkins folder %s is not available yet", jf.Name)
+		}
+
+		owner, ownerKind, folder = jf, consts.JenkinsFolderKind, jf.GetFolderName()
+	} else {
+		j, err := plutil.GetJenkinsInstanceOwner(k8sClient, instance.GetName(), instance.GetNamespace(), ownerName,
+			instance.GetOwnerReferences())
+		if err != nil {
+			return "", fmt.Errorf("failed to get jenkins owner: %w", err)
+		}
+
+		owner, ownerKind = j, consts.JenkinsKind
+	}
+
+	refs := make([]metav1.OwnerReference, 0, len(instance.GetOwnerReferences()))
+
+	for _, ref := range instance.GetOwnerReferences() {
+		isOwnerKind := ref.Kind == consts.JenkinsKind || ref.Kind == consts.JenkinsFolderKind
+		if !isOwnerKind || (ref.Kind == ownerKind && ref.Name == owner.GetName()) {
+			refs = append(refs, ref)
+		}
+	}
+
+	instance.SetOwnerReferences(refs)
+
+	if err := controllerutil.SetOwnerReference(owner, instance, k8sClient.Scheme()); err != nil {
+		return "", fmt.Errorf("failed to set owner reference: %w", err)
+	}
+
+	return folder, nil
+}
+
+// ValueOrDefault returns the value or the default value if the value is empty.
+func ValueOrDefault(value, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+
+	return value
+}
+
+// ContainsString reports whether the list contains the string.
+func ContainsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+
+	return false
+}
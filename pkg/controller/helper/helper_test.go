@@ -1,6 +1,7 @@
 package helper
 
 import (
+	"context"
 	"errors"
 	"os"
 	"testing"
@@ -10,7 +11,10 @@ import (
 	"github.com/stretchr/testify/require"
 	v1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
 )
@@ -126,3 +130,83 @@ func TestTryToDelete_DeleteFuncFailure(t *testing.T) {
 func TestJenkinsIsNotFoundErr(t *testing.T) {
 	assert.True(t, JenkinsIsNotFoundErr(errors.New("404")))
 }
+
+func TestUpdateKeepingStatus(t *testing.T) {
+	s := runtime.NewScheme()
+	require.NoError(t, jenkinsApi.AddToScheme(s))
+
+	stored := &jenkinsApi.JenkinsView{ObjectMeta: metav1.ObjectMeta{Name: "view", Namespace: "ns"}}
+	k8sClient := fake.NewClientBuilder().WithScheme(s).WithObjects(stored).Build()
+
+	instance := &jenkinsApi.JenkinsView{}
+	require.NoError(t, k8sClient.Get(context.Background(), client.ObjectKeyFromObject(stored), instance))
+
+	instance.Finalizers = []string{"finalizer"}
+	instance.Status.ViewName = "synced"
+
+	require.NoError(t, UpdateKeepingStatus(context.Background(), k8sClient, instance, &instance.Status))
+	require.Equal(t, "synced", instance.Status.ViewName)
+
+	got := &jenkinsApi.JenkinsView{}
+	require.NoError(t, k8sClient.Get(context.Background(), client.ObjectKeyFromObject(stored), got))
+	require.Equal(t, []string{"finalizer"}, got.Finalizers)
+}
+
+func TestSetFolderOrJenkinsOwner(t *testing.T) {
+	s := runtime.NewScheme()
+	require.NoError(t, jenkinsApi.AddToScheme(s))
+
+	jenkinsInstance := &jenkinsApi.Jenkins{ObjectMeta: metav1.ObjectMeta{Name: "jenkins", Namespace: "ns"}}
+	folder := &jenkinsApi.JenkinsFolder{
+		ObjectMeta: metav1.ObjectMeta{Name: "folder", Namespace: "ns"},
+		Status:     jenkinsApi.JenkinsFolderStatus{Available: true},
+	}
+	otherFolder := &jenkinsApi.JenkinsFolder{
+		ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "ns"},
+		Status:     jenkinsApi.JenkinsFolderStatus{Available: true},
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(s).WithObjects(jenkinsInstance, folder, otherFolder).Build()
+
+	folderName := "folder"
+	ownerRefs := func(o client.Object) []string {
+		var refs []string
+		for _, ref := range o.GetOwnerReferences() {
+			refs = append(refs, ref.Kind+"/"+ref.Name)
+		}
+
+		return refs
+	}
+
+	instance := &jenkinsApi.JenkinsView{ObjectMeta: metav1.ObjectMeta{Name: "view", Namespace: "ns"}}
+
+	got, err := SetFolderOrJenkinsOwner(k8sClient, instance, nil, nil)
+	require.NoError(t, err)
+	assert.Empty(t, got)
+	assert.Equal(t, []string{"Jenkins/jenkins"}, ownerRefs(instance))
+
+	got, err = SetFolderOrJenkinsOwner(k8sClient, instance, &folderName, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "folder", got)
+	assert.Equal(t, []string{"JenkinsFolder/folder"}, ownerRefs(instance))
+
+	folderName = "other"
+
+	_, err = SetFolderOrJenkinsOwner(k8sClient, instance, &folderName, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"JenkinsFolder/other"}, ownerRefs(instance))
+
+	_, err = SetFolderOrJenkinsOwner(k8sClient, instance, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Jenkins/jenkins"}, ownerRefs(instance))
+}
+
+func TestValueOrDefault(t *testing.T) {
+	assert.Equal(t, "value", ValueOrDefault("value", "default"))
+	assert.Equal(t, "default", ValueOrDefault("", "default"))
+}
+
+func TestContainsString(t *testing.T) {
+	assert.True(t, ContainsString([]string{"a", "b"}, "b"))
+	assert.False(t, ContainsString([]string{"a", "b"}, "c"))
+	assert.False(t, ContainsString(nil, "a"))
+}
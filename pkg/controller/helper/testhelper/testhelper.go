@@ -0,0 +1,105 @@
+// Package testhelper contains the fixtures shared by the controller tests.
+package testhelper
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/client/jenkins"
+)
+
+// NewFakeClient returns a fake client with the core, apps and operator types holding the given objects.
+// Like the API server, the client keeps the stored status on updates and returns it in the updated object.
+func NewFakeClient(t *testing.T, objects ...client.Object) client.Client {
+	t.Helper()
+
+	s := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(s))
+	require.NoError(t, appsv1.AddToScheme(s))
+	require.NoError(t, jenkinsApi.AddToScheme(s))
+
+	return &statusSubresourceClient{Client: fake.NewClientBuilder().WithScheme(s).WithObjects(objects...).Build()}
+}
+
+type statusSubresourceClient struct {
+	client.Client
+}
+
+func (c *statusSubresourceClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	// the stored object is read into a zero value, so the omitted status fields are not taken from the updated one.
+	stored, ok := reflect.New(reflect.TypeOf(obj).Elem()).Interface().(client.Object)
+	if !ok || c.Client.Get(ctx, client.ObjectKeyFromObject(obj), stored) != nil {
+		return c.Client.Update(ctx, obj, opts...)
+	}
+
+	updated, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return err
+	}
+
+	storedContent, err := runtime.DefaultUnstructuredConverter.ToUnstructured(stored)
+	if err != nil {
+		return err
+	}
+
+	delete(updated, "status")
+
+	if status, ok := storedContent["status"]; ok {
+		updated["status"] = status
+	}
+
+	v := reflect.ValueOf(obj).Elem()
+	v.Set(reflect.Zero(v.Type()))
+
+	if err = runtime.DefaultUnstructuredConverter.FromUnstructured(updated, obj); err != nil {
+		return err
+	}
+
+	return c.Client.Update(ctx, obj, opts...)
+}
+
+// NewClientFactory returns a factory which makes the given client for the instances without an owner name.
+func NewClientFactory(jClient *jenkins.ClientMock) *jenkins.ClientBuilderMock {
+	jBuilder := jenkins.ClientBuilderMock{}
+	jBuilder.On("MakeNewClient", (*string)(nil)).Return(jClient, nil)
+
+	return &jBuilder
+}
+
+// Reconcile reconciles the instance with the given name and requires the reconciliation not to fail.
+func Reconcile(t *testing.T, r reconcile.Reconciler, namespace, name string) reconcile.Result {
+	t.Helper()
+
+	res, err := r.Reconcile(context.Background(), reconcile.Request{
+		NamespacedName: types.NamespacedName{Namespace: namespace, Name: name},
+	})
+	require.NoError(t, err)
+
+	return res
+}
+
+// Get returns the stored instance with the given name.
+func Get[T any, PT interface {
+	*T
+	client.Object
+}](t *testing.T, k8sClient client.Client, namespace, name string) PT {
+	t.Helper()
+
+	instance := PT(new(T))
+
+	require.NoError(t, k8sClient.Get(context.Background(),
+		types.NamespacedName{Namespace: namespace, Name: name}, instance))
+
+	return instance
+}
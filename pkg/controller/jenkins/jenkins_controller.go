@@ -214,6 +214,51 @@ func (r *ReconcileJenkins) Reconcile(ctx context.Context, request reconcile.Requ
 		}
 	}
 
+	instance, upd, err = r.service.RotateAdminCredentials(instance)
+	if err != nil {
+		log.Error(err, "Admin credentials rotation has failed")
+
+		return reconcile.Result{RequeueAfter: helper.DefaultRequeueTime * time.Second},
+			fmt.Errorf("failed to rotate admin credentials: %w", err)
+	}
+
+	if upd {
+		if err = r.updateInstanceStatus(ctx, instance); err != nil {
+			return reconcile.Result{RequeueAfter: helper.DefaultRequeueTime * time.Second},
+				fmt.Errorf("failed to update instance status: %w", err)
+		}
+	}
+
+	instance, upd, err = r.service.SyncPlugins(instance)
+	if err != nil {
+		log.Error(err, "Plugins synchronization has failed")
+
+		return reconcile.Result{RequeueAfter: helper.DefaultRequeueTime * time.Second},
+			fmt.Errorf("failed to sync plugins: %w", err)
+	}
+
+	if upd {
+		if err = r.updateInstanceStatus(ctx, instance); err != nil {
+			return reconcile.Result{RequeueAfter: helper.DefaultRequeueTime * time.Second},
+				fmt.Errorf("failed to update instance status: %w", err)
+		}
+	}
+
+	instance, upd, err = r.service.SyncKubernetesClouds(instance)
+	if err != nil {
+		log.Error(err, "Kubernetes clouds synchronization has failed")
+
+		return reconcile.Result{RequeueAfter: helper.DefaultRequeueTime * time.Second},
+			fmt.Errorf("failed to sync kubernetes clouds: %w", err)
+	}
+
+	if upd {
+		if err = r.updateInstanceStatus(ctx, instance); err != nil {
+			return reconcile.Result{RequeueAfter: helper.DefaultRequeueTime * time.Second},
+				fmt.Errorf("failed to update instance status: %w", err)
+		}
+	}
+
 	if err = r.updateAvailableStatus(ctx, instance, true); err != nil {
 		log.Info("Failed to update availability status")
 
@@ -685,6 +685,9 @@ func TestReconcileJenkins_Reconcile_updateAvailableStatusErr(t *testing.T) {
 	serv.On("Configure", mock.AnythingOfType("*v1.Jenkins")).Return(instance, true, nil)
 	serv.On("ExposeConfiguration", mock.AnythingOfType("*v1.Jenkins")).Return(instance, false, nil)
 	serv.On("Integration", mock.AnythingOfType("*v1.Jenkins")).Return(instance, true, nil)
+	serv.On("RotateAdminCredentials", mock.AnythingOfType("*v1.Jenkins")).Return(instance, false, nil)
+	serv.On("SyncPlugins", mock.AnythingOfType("*v1.Jenkins")).Return(instance, false, nil)
+	serv.On("SyncKubernetesClouds", mock.AnythingOfType("*v1.Jenkins")).Return(instance, false, nil)
 
 	log := &common.Logger{}
 	rg := ReconcileJenkins{
@@ -725,6 +728,9 @@ func TestReconcileJenkins_Reconcile_AllValid(t *testing.T) {
 	serv.On("Configure", mock.AnythingOfType("*v1.Jenkins")).Return(instance, true, nil)
 	serv.On("ExposeConfiguration", mock.AnythingOfType("*v1.Jenkins")).Return(instance, false, nil)
 	serv.On("Integration", mock.AnythingOfType("*v1.Jenkins")).Return(instance, true, nil)
+	serv.On("RotateAdminCredentials", mock.AnythingOfType("*v1.Jenkins")).Return(instance, false, nil)
+	serv.On("SyncPlugins", mock.AnythingOfType("*v1.Jenkins")).Return(instance, false, nil)
+	serv.On("SyncKubernetesClouds", mock.AnythingOfType("*v1.Jenkins")).Return(instance, false, nil)
 
 	log := &common.Logger{}
 	rg := ReconcileJenkins{
@@ -743,3 +749,120 @@ func TestReconcileJenkins_Reconcile_AllValid(t *testing.T) {
 	sw.AssertExpectations(t)
 	serv.AssertExpectations(t)
 }
+
+func TestReconcileJenkins_Reconcile_RotateAdminCredentialsErr(t *testing.T) {
+	ctx := context.Background()
+	sw := &mocks.StatusWriter{}
+	mc := mocks.Client{}
+	serv := smock.JenkinsService{}
+
+	s := runtime.NewScheme()
+	instance := createJenkinsByStatus(StatusReady)
+
+	s.AddKnownTypes(v1.SchemeGroupVersion, &jenkinsApi.Jenkins{})
+	cl := fake.NewClientBuilder().WithObjects(instance).WithScheme(s).Build()
+
+	mc.On("Get", nsn, &jenkinsApi.Jenkins{}).Return(cl)
+	serv.On("CreateAdminPassword", mock.AnythingOfType("*v1.Jenkins")).Return(nil)
+	serv.On("IsDeploymentReady", mock.AnythingOfType("*v1.Jenkins")).Return(true, nil)
+	serv.On("Configure", mock.AnythingOfType("*v1.Jenkins")).Return(instance, true, nil)
+	serv.On("ExposeConfiguration", mock.AnythingOfType("*v1.Jenkins")).Return(instance, false, nil)
+	serv.On("Integration", mock.AnythingOfType("*v1.Jenkins")).Return(instance, true, nil)
+	serv.On("RotateAdminCredentials", mock.AnythingOfType("*v1.Jenkins")).
+		Return(instance, false, errors.New("test"))
+
+	rg := ReconcileJenkins{
+		client:  &mc,
+		log:     &common.Logger{},
+		service: &serv,
+	}
+	req := reconcile.Request{
+		NamespacedName: nsn,
+	}
+	rs, err := rg.Reconcile(ctx, req)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to rotate admin credentials")
+	assert.Equal(t, reconcile.Result{RequeueAfter: helper.DefaultRequeueTime * time.Second}, rs)
+	sw.AssertExpectations(t)
+	serv.AssertExpectations(t)
+}
+
+func TestReconcileJenkins_Reconcile_SyncPluginsErr(t *testing.T) {
+	ctx := context.Background()
+	sw := &mocks.StatusWriter{}
+	mc := mocks.Client{}
+	serv := smock.JenkinsService{}
+
+	s := runtime.NewScheme()
+	instance := createJenkinsByStatus(StatusReady)
+
+	s.AddKnownTypes(v1.SchemeGroupVersion, &jenkinsApi.Jenkins{})
+	cl := fake.NewClientBuilder().WithObjects(instance).WithScheme(s).Build()
+
+	mc.On("Get", nsn, &jenkinsApi.Jenkins{}).Return(cl)
+	serv.On("CreateAdminPassword", mock.AnythingOfType("*v1.Jenkins")).Return(nil)
+	serv.On("IsDeploymentReady", mock.AnythingOfType("*v1.Jenkins")).Return(true, nil)
+	serv.On("Configure", mock.AnythingOfType("*v1.Jenkins")).Return(instance, true, nil)
+	serv.On("ExposeConfiguration", mock.AnythingOfType("*v1.Jenkins")).Return(instance, false, nil)
+	serv.On("Integration", mock.AnythingOfType("*v1.Jenkins")).Return(instance, true, nil)
+	serv.On("RotateAdminCredentials", mock.AnythingOfType("*v1.Jenkins")).Return(instance, false, nil)
+	serv.On("SyncPlugins", mock.AnythingOfType("*v1.Jenkins")).
+		Return(instance, false, errors.New("test"))
+
+	rg := ReconcileJenkins{
+		client:  &mc,
+		log:     &common.Logger{},
+		service: &serv,
+	}
+	req := reconcile.Request{
+		NamespacedName: nsn,
+	}
+	rs, err := rg.Reconcile(ctx, req)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to sync plugins")
+	assert.Equal(t, reconcile.Result{RequeueAfter: helper.DefaultRequeueTime * time.Second}, rs)
+	sw.AssertExpectations(t)
+	serv.AssertExpectations(t)
+}
+
+func TestReconcileJenkins_Reconcile_SyncKubernetesCloudsErr(t *testing.T) {
+	ctx := context.Background()
+	sw := &mocks.StatusWriter{}
+	mc := mocks.Client{}
+	serv := smock.JenkinsService{}
+
+	s := runtime.NewScheme()
+	instance := createJenkinsByStatus(StatusReady)
+
+	s.AddKnownTypes(v1.SchemeGroupVersion, &jenkinsApi.Jenkins{})
+	cl := fake.NewClientBuilder().WithObjects(instance).WithScheme(s).Build()
+
+	mc.On("Get", nsn, &jenkinsApi.Jenkins{}).Return(cl)
+	serv.On("CreateAdminPassword", mock.AnythingOfType("*v1.Jenkins")).Return(nil)
+	serv.On("IsDeploymentReady", mock.AnythingOfType("*v1.Jenkins")).Return(true, nil)
+	serv.On("Configure", mock.AnythingOfType("*v1.Jenkins")).Return(instance, true, nil)
+	serv.On("ExposeConfiguration", mock.AnythingOfType("*v1.Jenkins")).Return(instance, false, nil)
+	serv.On("Integration", mock.AnythingOfType("*v1.Jenkins")).Return(instance, true, nil)
+	serv.On("RotateAdminCredentials", mock.AnythingOfType("*v1.Jenkins")).Return(instance, false, nil)
+	serv.On("SyncPlugins", mock.AnythingOfType("*v1.Jenkins")).Return(instance, false, nil)
+	serv.On("SyncKubernetesClouds", mock.AnythingOfType("*v1.Jenkins")).
+		Return(instance, false, errors.New("test"))
+
+	rg := ReconcileJenkins{
+		client:  &mc,
+		log:     &common.Logger{},
+		service: &serv,
+	}
+	req := reconcile.Request{
+		NamespacedName: nsn,
+	}
+	rs, err := rg.Reconcile(ctx, req)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to sync kubernetes clouds")
+	assert.Equal(t, reconcile.Result{RequeueAfter: helper.DefaultRequeueTime * time.Second}, rs)
+	sw.AssertExpectations(t)
+	serv.AssertExpectations(t)
+}
@@ -0,0 +1,412 @@
+package jenkins_agentpool
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/client/jenkins"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/helper"
+	jenkinsDefaultSpec "github.com/epam/edp-jenkins-operator/v2/pkg/service/jenkins/spec"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/service/platform"
+	plutil "github.com/epam/edp-jenkins-operator/v2/pkg/util/platform"
+)
+
+const (
+	finalizerName = "jenkinsagentpool.jenkins.finalizer.name"
+
+	// syncInterval is how often the nodes are saved again to correct the changes made in Jenkins.
+	syncInterval = 10 * time.Minute
+
+	defaultExecutors = 1
+	defaultRemoteFS  = "/home/jenkins/agent"
+	defaultImage     = "jenkins/inbound-agent"
+
+	agentContainerName = "jnlp"
+	poolLabel          = "app.edp.epam.com/agent-pool"
+
+	secretNameFormat  = "%s-agent-secrets"
+	secretsVolumeName = "agent-secrets"
+	secretsMountPath  = "/var/run/jenkins-agent"
+
+	// agentCommand reads the secret of the node named after the pod and starts the inbound agent of the image.
+	agentCommand = `export JENKINS_SECRET="$(cat ` + secretsMountPath + `/${JENKINS_AGENT_NAME})" && exec jenkins-agent`
+)
+
+type Reconcile struct {
+	client               client.Client
+	log                  logr.Logger
+	jenkinsClientFactory jenkins.ClientFactory
+}
+
+func NewReconciler(k8sCl client.Client, logf logr.Logger, ps platform.PlatformService) *Reconcile {
+	return &Reconcile{
+		client:               k8sCl,
+		log:                  logf.WithName("controller_jenkins_agent_pool"),
+		jenkinsClientFactory: jenkins.MakeClientBuilder(ps, k8sCl),
+	}
+}
+
+func (r *Reconcile) SetupWithManager(mgr ctrl.Manager) error {
+	p := predicate.Funcs{
+		UpdateFunc: specUpdated,
+	}
+
+	if err := ctrl.NewControllerManagedBy(mgr).
+		For(&jenkinsApi.JenkinsAgentPool{}, builder.WithPredicates(p)).
+		Owns(&appsv1.StatefulSet{}).
+		Complete(r); err != nil {
+		return fmt.Errorf("failed to create new managed controller: %w", err)
+	}
+
+	return nil
+}
+
+func specUpdated(e event.UpdateEvent) bool {
+	oldObject, ok := e.ObjectOld.(*jenkinsApi.JenkinsAgentPool)
+	if !ok {
+		return false
+	}
+
+	newObject, ok := e.ObjectNew.(*jenkinsApi.JenkinsAgentPool)
+	if !ok {
+		return false
+	}
+
+	return !reflect.DeepEqual(oldObject.Spec, newObject.Spec) ||
+		(oldObject.GetDeletionTimestamp().IsZero() && !newObject.GetDeletionTimestamp().IsZero())
+}
+
+func (r *Reconcile) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	reqLogger := r.log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+	reqLogger.Info("Reconciling JenkinsAgentPool has been started")
+
+	instance := new(jenkinsApi.JenkinsAgentPool)
+
+	if err := r.client.Get(ctx, request.NamespacedName, instance); err != nil {
+		if k8serrors.IsNotFound(err) {
+			reqLogger.Info("instance not found")
+
+			return reconcile.Result{}, nil
+		}
+
+		return reconcile.Result{}, fmt.Errorf("failed to get JenkinsAgentPool instance: %w", err)
+	}
+
+	if err := r.tryToReconcile(ctx, instance); err != nil {
+		r.log.Error(err, "error during reconciliation", "instance", instance)
+		r.updateInstanceStatus(ctx, instance, err.Error())
+
+		return reconcile.Result{RequeueAfter: helper.DefaultRequeueTime * time.Second}, nil
+	}
+
+	r.updateInstanceStatus(ctx, instance, helper.StatusSuccess)
+
+	reqLogger.Info("Reconciling JenkinsAgentPool has been finished")
+
+	if !instance.GetDeletionTimestamp().IsZero() {
+		return reconcile.Result{}, nil
+	}
+
+	return reconcile.Result{RequeueAfter: syncInterval}, nil
+}
+
+func (r *Reconcile) tryToReconcile(ctx context.Context, instance *jenkinsApi.JenkinsAgentPool) error {
+	jc, err := r.jenkinsClientFactory.MakeNewClient(&instance.ObjectMeta, instance.Spec.OwnerName)
+	if err != nil {
+		return fmt.Errorf("failed to create gojenkins client: %w", err)
+	}
+
+	if instance.GetDeletionTimestamp().IsZero() {
+		if err = r.syncPool(ctx, instance, jc); err != nil {
+			return err
+		}
+	}
+
+	updateNeeded, err := helper.TryToDelete(instance, finalizerName, makeDeletionFunc(instance, jc))
+	if err != nil {
+		return fmt.Errorf("failed to delete instance: %w", err)
+	}
+
+	if updateNeeded {
+		return helper.UpdateKeepingStatus(ctx, r.client, instance, &instance.Status)
+	}
+
+	return nil
+}
+
+// syncPool saves the nodes and their secrets, scales the StatefulSet and deletes the nodes of the removed pods.
+func (r *Reconcile) syncPool(ctx context.Context, instance *jenkinsApi.JenkinsAgentPool, jc jenkins.ClientInterface) error {
+	nodes := nodeNames(instance)
+
+	// the nodes are recorded before they are created, so they are cleaned up even if the pool fails in between.
+	instance.Status.Nodes = mergeNodes(instance.Status.Nodes, nodes)
+
+	secrets := make(map[string][]byte, len(nodes))
+
+	for _, name := range nodes {
+		secret, err := jc.SaveAgentNode(agentNode(instance, name))
+		if err != nil {
+			return fmt.Errorf("failed to save node %s: %w", name, err)
+		}
+
+		secrets[name] = []byte(secret)
+	}
+
+	if err := r.saveSecret(ctx, instance, secrets); err != nil {
+		return err
+	}
+
+	sts, err := r.saveStatefulSet(ctx, instance)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range instance.Status.Nodes {
+		if helper.ContainsString(nodes, name) {
+			continue
+		}
+
+		if err = jc.DeleteAgentNode(name); err != nil {
+			return fmt.Errorf("failed to delete node %s: %w", name, err)
+		}
+
+		r.log.Info("agent node has been deleted", "instance", instance.Name, "node", name)
+	}
+
+	instance.Status.Nodes = nodes
+	instance.Status.ReadyReplicas = sts.Status.ReadyReplicas
+
+	return nil
+}
+
+func (r *Reconcile) saveSecret(ctx context.Context, instance *jenkinsApi.JenkinsAgentPool, secrets map[string][]byte) error {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+		Name:      secretName(instance),
+		Namespace: instance.Namespace,
+	}}
+
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.client, secret, func() error {
+		secret.Data = secrets
+
+		if metav1.GetControllerOf(secret) != nil {
+			return nil
+		}
+
+		return controllerutil.SetControllerReference(instance, secret, r.client.Scheme())
+	}); err != nil {
+		return fmt.Errorf("failed to save secret %s: %w", secret.Name, err)
+	}
+
+	return nil
+}
+
+func (r *Reconcile) saveStatefulSet(ctx context.Context, instance *jenkinsApi.JenkinsAgentPool) (*appsv1.StatefulSet, error) {
+	jenkinsURL, err := r.jenkinsURL(instance)
+	if err != nil {
+		return nil, err
+	}
+
+	sts := &appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{
+		Name:      instance.Name,
+		Namespace: instance.Namespace,
+	}}
+
+	if _, err = controllerutil.CreateOrUpdate(ctx, r.client, sts, func() error {
+		if sts.CreationTimestamp.IsZero() {
+			// the selector, service name and pod management policy can not be changed later.
+			sts.Spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{poolLabel: instance.Name}}
+			sts.Spec.ServiceName = instance.Name
+			sts.Spec.PodManagementPolicy = appsv1.ParallelPodManagement
+		}
+
+		replicas := instance.Spec.Replicas
+		sts.Spec.Replicas = &replicas
+		sts.Spec.Template = podTemplate(instance, jenkinsURL)
+
+		if metav1.GetControllerOf(sts) != nil {
+			return nil
+		}
+
+		return controllerutil.SetControllerReference(instance, sts, r.client.Scheme())
+	}); err != nil {
+		return nil, fmt.Errorf("failed to save stateful set %s: %w", sts.Name, err)
+	}
+
+	return sts, nil
+}
+
+// jenkinsURL returns the URL from the spec or the service URL of the owner Jenkins.
+func (r *Reconcile) jenkinsURL(instance *jenkinsApi.JenkinsAgentPool) (string, error) {
+	if instance.Spec.JenkinsURL != "" {
+		return instance.Spec.JenkinsURL, nil
+	}
+
+	owner, err := plutil.GetJenkinsInstanceOwner(r.client, instance.Name, instance.Namespace, instance.Spec.OwnerName,
+		instance.GetOwnerReferences())
+	if err != nil {
+		return "", fmt.Errorf("failed to get owner jenkins: %w", err)
+	}
+
+	return fmt.Sprintf("http://%v:%v/%v", owner.Name, jenkinsDefaultSpec.JenkinsDefaultUiPort, owner.Spec.BasePath), nil
+}
+
+// podTemplate adds the connection settings and the secrets volume to the jnlp container of the template.
+func podTemplate(instance *jenkinsApi.JenkinsAgentPool, jenkinsURL string) corev1.PodTemplateSpec {
+	var tpl corev1.PodTemplateSpec
+	if instance.Spec.Template != nil {
+		tpl = *instance.Spec.Template.DeepCopy()
+	}
+
+	if tpl.Labels == nil {
+		tpl.Labels = make(map[string]string)
+	}
+
+	tpl.Labels[poolLabel] = instance.Name
+
+	idx := -1
+
+	for i := range tpl.Spec.Containers {
+		if tpl.Spec.Containers[i].Name == agentContainerName {
+			idx = i
+		}
+	}
+
+	if idx < 0 {
+		tpl.Spec.Containers = append(tpl.Spec.Containers, corev1.Container{Name: agentContainerName})
+		idx = len(tpl.Spec.Containers) - 1
+	}
+
+	c := &tpl.Spec.Containers[idx]
+
+	if c.Image == "" {
+		c.Image = helper.ValueOrDefault(instance.Spec.Image, defaultImage)
+	}
+
+	c.Command = []string{"/bin/sh", "-c", agentCommand}
+	c.Args = nil
+
+	setEnv(c, corev1.EnvVar{Name: "JENKINS_URL", Value: jenkinsURL})
+	setEnv(c, corev1.EnvVar{Name: "JENKINS_AGENT_NAME", ValueFrom: &corev1.EnvVarSource{
+		FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.name"},
+	}})
+	setEnv(c, corev1.EnvVar{Name: "JENKINS_AGENT_WORKDIR", Value: helper.ValueOrDefault(instance.Spec.RemoteFS, defaultRemoteFS)})
+
+	if instance.Spec.WebSocket {
+		setEnv(c, corev1.EnvVar{Name: "JENKINS_WEB_SOCKET", Value: "true"})
+	}
+
+	c.VolumeMounts = append(c.VolumeMounts, corev1.VolumeMount{
+		Name:      secretsVolumeName,
+		MountPath: secretsMountPath,
+		ReadOnly:  true,
+	})
+
+	tpl.Spec.Volumes = append(tpl.Spec.Volumes, corev1.Volume{
+		Name: secretsVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{SecretName: secretName(instance)},
+		},
+	})
+
+	return tpl
+}
+
+func setEnv(c *corev1.Container, env corev1.EnvVar) {
+	for i := range c.Env {
+		if c.Env[i].Name == env.Name {
+			c.Env[i] = env
+
+			return
+		}
+	}
+
+	c.Env = append(c.Env, env)
+}
+
+func agentNode(instance *jenkinsApi.JenkinsAgentPool, name string) *jenkins.AgentNode {
+	labels := instance.Spec.Labels
+	if len(labels) == 0 {
+		labels = []string{instance.Name}
+	}
+
+	executors := int32(defaultExecutors)
+	if instance.Spec.Executors != nil {
+		executors = *instance.Spec.Executors
+	}
+
+	return &jenkins.AgentNode{
+		Name:        name,
+		Description: fmt.Sprintf("Agent of the %s pool managed by the operator", instance.Name),
+		RemoteFS:    helper.ValueOrDefault(instance.Spec.RemoteFS, defaultRemoteFS),
+		Labels:      strings.Join(labels, " "),
+		Executors:   executors,
+		Exclusive:   instance.Spec.Exclusive,
+		WebSocket:   instance.Spec.WebSocket,
+	}
+}
+
+// nodeNames returns the names of the StatefulSet pods, the nodes are named the same way.
+func nodeNames(instance *jenkinsApi.JenkinsAgentPool) []string {
+	names := make([]string, 0, instance.Spec.Replicas)
+	for i := int32(0); i < instance.Spec.Replicas; i++ {
+		names = append(names, fmt.Sprintf("%s-%d", instance.Name, i))
+	}
+
+	return names
+}
+
+func mergeNodes(current, desired []string) []string {
+	merged := append([]string(nil), current...)
+
+	for _, name := range desired {
+		if !helper.ContainsString(merged, name) {
+			merged = append(merged, name)
+		}
+	}
+
+	return merged
+}
+
+func secretName(instance *jenkinsApi.JenkinsAgentPool) string {
+	return fmt.Sprintf(secretNameFormat, instance.Name)
+}
+
+func makeDeletionFunc(instance *jenkinsApi.JenkinsAgentPool, jc jenkins.ClientInterface) func() error {
+	return func() error {
+		// the StatefulSet and the secrets are garbage collected with the instance.
+		for _, name := range mergeNodes(instance.Status.Nodes, nodeNames(instance)) {
+			if err := jc.DeleteAgentNode(name); err != nil {
+				return fmt.Errorf("failed to delete node %s: %w", name, err)
+			}
+		}
+
+		instance.Status.Nodes = nil
+
+		return nil
+	}
+}
+
+func (r *Reconcile) updateInstanceStatus(ctx context.Context, instance *jenkinsApi.JenkinsAgentPool, statusValue string) {
+	instance.Status.Value = statusValue
+
+	if err := r.client.Status().Update(ctx, instance); err != nil {
+		r.log.Error(err, "unable to update status", "instance", instance)
+	}
+}
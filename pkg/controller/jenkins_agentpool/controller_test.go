@@ -0,0 +1,219 @@
+package jenkins_agentpool
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	pmock "github.com/epam/edp-jenkins-operator/v2/mock/platform"
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/client/jenkins"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/helper"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/helper/testhelper"
+)
+
+const (
+	name      = "licensed"
+	namespace = "ns"
+)
+
+func getTestAgentPool() *jenkinsApi.JenkinsAgentPool {
+	return &jenkinsApi.JenkinsAgentPool{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: jenkinsApi.JenkinsAgentPoolSpec{
+			Replicas: 2,
+			Labels:   []string{"licensed", "cache"},
+		},
+	}
+}
+
+func getTestAgentNode(nodeName string) *jenkins.AgentNode {
+	return &jenkins.AgentNode{
+		Name:        nodeName,
+		Description: "Agent of the licensed pool managed by the operator",
+		RemoteFS:    defaultRemoteFS,
+		Labels:      "licensed cache",
+		Executors:   defaultExecutors,
+	}
+}
+
+func newTestReconcile(t *testing.T, jClient *jenkins.ClientMock, objects ...client.Object) (*Reconcile, client.Client) {
+	t.Helper()
+
+	jenkinsInstance := &jenkinsApi.Jenkins{
+		ObjectMeta: metav1.ObjectMeta{Name: "jenkins", Namespace: namespace},
+	}
+
+	k8sClient := testhelper.NewFakeClient(t, append(objects, jenkinsInstance)...)
+
+	return &Reconcile{
+		client:               k8sClient,
+		jenkinsClientFactory: testhelper.NewClientFactory(jClient),
+		log:                  &helper.LoggerMock{},
+	}, k8sClient
+}
+
+func TestReconcile_Reconcile(t *testing.T) {
+	instance := getTestAgentPool()
+
+	jClient := jenkins.ClientMock{}
+	jClient.On("SaveAgentNode", getTestAgentNode("licensed-0")).Return("secret-0", nil)
+	jClient.On("SaveAgentNode", getTestAgentNode("licensed-1")).Return("secret-1", nil)
+
+	r, k8sClient := newTestReconcile(t, &jClient, instance)
+
+	res := testhelper.Reconcile(t, r, namespace, name)
+	require.Equal(t, syncInterval, res.RequeueAfter)
+	jClient.AssertExpectations(t)
+
+	got := testhelper.Get[jenkinsApi.JenkinsAgentPool](t, k8sClient, namespace, name)
+	require.Equal(t, helper.StatusSuccess, got.Status.Value)
+	require.Equal(t, []string{"licensed-0", "licensed-1"}, got.Status.Nodes)
+	require.Equal(t, []string{finalizerName}, got.Finalizers)
+
+	var secret corev1.Secret
+	require.NoError(t, k8sClient.Get(context.Background(),
+		types.NamespacedName{Namespace: namespace, Name: "licensed-agent-secrets"}, &secret))
+	require.Equal(t, map[string][]byte{
+		"licensed-0": []byte("secret-0"),
+		"licensed-1": []byte("secret-1"),
+	}, secret.Data)
+	require.Equal(t, name, metav1.GetControllerOf(&secret).Name)
+
+	var sts appsv1.StatefulSet
+	require.NoError(t, k8sClient.Get(context.Background(), types.NamespacedName{Namespace: namespace, Name: name}, &sts))
+	require.Equal(t, int32(2), *sts.Spec.Replicas)
+	require.Equal(t, map[string]string{poolLabel: name}, sts.Spec.Selector.MatchLabels)
+	require.Equal(t, name, metav1.GetControllerOf(&sts).Name)
+
+	require.Len(t, sts.Spec.Template.Spec.Containers, 1)
+	c := sts.Spec.Template.Spec.Containers[0]
+	require.Equal(t, defaultImage, c.Image)
+	require.Equal(t, []string{"/bin/sh", "-c", agentCommand}, c.Command)
+	require.Contains(t, c.Env, corev1.EnvVar{Name: "JENKINS_URL", Value: "http://jenkins:8080/"})
+	require.Contains(t, c.VolumeMounts, corev1.VolumeMount{Name: secretsVolumeName, MountPath: secretsMountPath, ReadOnly: true})
+	require.Equal(t, "licensed-agent-secrets", sts.Spec.Template.Spec.Volumes[0].Secret.SecretName)
+}
+
+func TestReconcile_Reconcile_ScaleDown(t *testing.T) {
+	instance := getTestAgentPool()
+	instance.Finalizers = []string{finalizerName}
+	instance.Spec.Replicas = 1
+	instance.Status.Nodes = []string{"licensed-0", "licensed-1", "licensed-2"}
+
+	jClient := jenkins.ClientMock{}
+	jClient.On("SaveAgentNode", getTestAgentNode("licensed-0")).Return("secret-0", nil)
+	jClient.On("DeleteAgentNode", "licensed-1").Return(nil)
+	jClient.On("DeleteAgentNode", "licensed-2").Return(nil)
+
+	r, k8sClient := newTestReconcile(t, &jClient, instance)
+
+	testhelper.Reconcile(t, r, namespace, name)
+	jClient.AssertExpectations(t)
+
+	got := testhelper.Get[jenkinsApi.JenkinsAgentPool](t, k8sClient, namespace, name)
+	require.Equal(t, helper.StatusSuccess, got.Status.Value)
+	require.Equal(t, []string{"licensed-0"}, got.Status.Nodes)
+
+	var sts appsv1.StatefulSet
+	require.NoError(t, k8sClient.Get(context.Background(), types.NamespacedName{Namespace: namespace, Name: name}, &sts))
+	require.Equal(t, int32(1), *sts.Spec.Replicas)
+}
+
+func TestReconcile_Reconcile_SaveNodeErr(t *testing.T) {
+	instance := getTestAgentPool()
+	instance.Spec.Replicas = 1
+
+	jClient := jenkins.ClientMock{}
+	jClient.On("SaveAgentNode", getTestAgentNode("licensed-0")).Return("", errors.New("save fatal"))
+
+	r, k8sClient := newTestReconcile(t, &jClient, instance)
+
+	res := testhelper.Reconcile(t, r, namespace, name)
+	require.Equal(t, helper.DefaultRequeueTime, int(res.RequeueAfter.Seconds()))
+
+	got := testhelper.Get[jenkinsApi.JenkinsAgentPool](t, k8sClient, namespace, name)
+	require.Equal(t, "failed to save node licensed-0: save fatal", got.Status.Value)
+	require.Equal(t, []string{"licensed-0"}, got.Status.Nodes)
+}
+
+func TestReconcile_Reconcile_Delete(t *testing.T) {
+	instance := getTestAgentPool()
+	instance.Finalizers = []string{finalizerName}
+	instance.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	instance.Status.Nodes = []string{"licensed-0", "licensed-1", "licensed-2"}
+
+	jClient := jenkins.ClientMock{}
+	jClient.On("DeleteAgentNode", "licensed-0").Return(nil)
+	jClient.On("DeleteAgentNode", "licensed-1").Return(nil)
+	jClient.On("DeleteAgentNode", "licensed-2").Return(nil)
+
+	r, k8sClient := newTestReconcile(t, &jClient, instance)
+
+	res := testhelper.Reconcile(t, r, namespace, name)
+	require.Zero(t, res.RequeueAfter)
+	jClient.AssertExpectations(t)
+
+	require.Empty(t, testhelper.Get[jenkinsApi.JenkinsAgentPool](t, k8sClient, namespace, name).Finalizers)
+}
+
+func TestReconcile_Reconcile_NotFound(t *testing.T) {
+	r, _ := newTestReconcile(t, &jenkins.ClientMock{})
+
+	res := testhelper.Reconcile(t, r, namespace, name)
+	require.Equal(t, reconcile.Result{}, res)
+}
+
+func TestPodTemplate(t *testing.T) {
+	instance := getTestAgentPool()
+	instance.Spec.WebSocket = true
+	instance.Spec.Template = &corev1.PodTemplateSpec{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{Name: "tools", Image: "licensed/tools"},
+				{
+					Name:  agentContainerName,
+					Image: "custom/agent",
+					Env:   []corev1.EnvVar{{Name: "JENKINS_URL", Value: "http://old"}, {Name: "TZ", Value: "UTC"}},
+				},
+			},
+		},
+	}
+
+	tpl := podTemplate(instance, "http://jenkins:8080/")
+
+	require.Equal(t, map[string]string{poolLabel: name}, tpl.Labels)
+	require.Len(t, tpl.Spec.Containers, 2)
+	require.Equal(t, instance.Spec.Template.Spec.Containers[0], tpl.Spec.Containers[0])
+
+	c := tpl.Spec.Containers[1]
+	require.Equal(t, "custom/agent", c.Image)
+	require.Equal(t, []corev1.EnvVar{
+		{Name: "JENKINS_URL", Value: "http://jenkins:8080/"},
+		{Name: "TZ", Value: "UTC"},
+		{Name: "JENKINS_AGENT_NAME", ValueFrom: &corev1.EnvVarSource{
+			FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.name"},
+		}},
+		{Name: "JENKINS_AGENT_WORKDIR", Value: defaultRemoteFS},
+		{Name: "JENKINS_WEB_SOCKET", Value: "true"},
+	}, c.Env)
+
+	// the template of the instance is not changed.
+	require.Nil(t, instance.Spec.Template.Labels)
+	require.Len(t, instance.Spec.Template.Spec.Containers[1].Env, 2)
+}
+
+func TestNewReconciler(t *testing.T) {
+	r := NewReconciler(fake.NewClientBuilder().Build(), &helper.LoggerMock{}, &pmock.PlatformService{})
+	require.NotNil(t, r.jenkinsClientFactory)
+}
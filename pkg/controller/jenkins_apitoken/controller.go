@@ -0,0 +1,372 @@
+package jenkins_apitoken
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/robfig/cron/v3"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/client/jenkins"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/helper"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/service/platform"
+)
+
+const (
+	finalizerName = "jenkinsapitoken.jenkins.finalizer.name"
+
+	// syncInterval is how often the token Secret is checked when the token is not going to be rotated earlier.
+	syncInterval = 10 * time.Minute
+
+	secretUserKey  = "username"
+	secretTokenKey = "token"
+)
+
+type Reconcile struct {
+	client               client.Client
+	log                  logr.Logger
+	jenkinsClientFactory jenkins.ClientFactory
+	now                  func() time.Time
+}
+
+func NewReconciler(k8sCl client.Client, logf logr.Logger, ps platform.PlatformService) *Reconcile {
+	return &Reconcile{
+		client:               k8sCl,
+		log:                  logf.WithName("controller_jenkins_apitoken"),
+		jenkinsClientFactory: jenkins.MakeClientBuilder(ps, k8sCl),
+		now:                  time.Now,
+	}
+}
+
+func (r *Reconcile) SetupWithManager(mgr ctrl.Manager) error {
+	p := predicate.Funcs{
+		UpdateFunc: specUpdated,
+	}
+
+	if err := ctrl.NewControllerManagedBy(mgr).
+		For(&jenkinsApi.JenkinsAPIToken{}, builder.WithPredicates(p)).
+		Complete(r); err != nil {
+		return fmt.Errorf("failed to create new managed controller: %w", err)
+	}
+
+	return nil
+}
+
+func specUpdated(e event.UpdateEvent) bool {
+	oldObject, ok := e.ObjectOld.(*jenkinsApi.JenkinsAPIToken)
+	if !ok {
+		return false
+	}
+
+	newObject, ok := e.ObjectNew.(*jenkinsApi.JenkinsAPIToken)
+	if !ok {
+		return false
+	}
+
+	return !reflect.DeepEqual(oldObject.Spec, newObject.Spec) ||
+		(oldObject.GetDeletionTimestamp().IsZero() && !newObject.GetDeletionTimestamp().IsZero())
+}
+
+func (r *Reconcile) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	reqLogger := r.log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+	reqLogger.Info("Reconciling JenkinsAPIToken has been started")
+
+	instance := new(jenkinsApi.JenkinsAPIToken)
+
+	if err := r.client.Get(ctx, request.NamespacedName, instance); err != nil {
+		if k8serrors.IsNotFound(err) {
+			reqLogger.Info("instance not found")
+
+			return reconcile.Result{}, nil
+		}
+
+		return reconcile.Result{}, fmt.Errorf("failed to get JenkinsAPIToken instance: %w", err)
+	}
+
+	jc, err := r.jenkinsClientFactory.MakeNewClient(&instance.ObjectMeta, instance.Spec.OwnerName)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to create gojenkins client: %w", err)
+	}
+
+	if err := r.tryToReconcile(ctx, instance, jc); err != nil {
+		r.log.Error(err, "error during reconciliation", "instance", instance)
+		r.updateInstanceStatus(ctx, instance, err.Error())
+
+		return reconcile.Result{RequeueAfter: helper.DefaultRequeueTime * time.Second}, nil
+	}
+
+	r.updateInstanceStatus(ctx, instance, helper.StatusSuccess)
+
+	reqLogger.Info("Reconciling JenkinsAPIToken has been finished")
+
+	if !instance.GetDeletionTimestamp().IsZero() {
+		return reconcile.Result{}, nil
+	}
+
+	return reconcile.Result{RequeueAfter: r.requeueAfter(instance)}, nil
+}
+
+func (r *Reconcile) tryToReconcile(
+	ctx context.Context,
+	instance *jenkinsApi.JenkinsAPIToken,
+	jc jenkins.ClientInterface,
+) error {
+	if instance.GetDeletionTimestamp().IsZero() {
+		if err := r.syncToken(ctx, instance, jc); err != nil {
+			return err
+		}
+	}
+
+	updateNeeded, err := helper.TryToDelete(instance, finalizerName, makeDeletionFunc(instance, jc))
+	if err != nil {
+		return fmt.Errorf("failed to delete instance: %w", err)
+	}
+
+	if updateNeeded {
+		return helper.UpdateKeepingStatus(ctx, r.client, instance, &instance.Status)
+	}
+
+	return nil
+}
+
+// syncToken issues a new token when there is no valid one or it is time to rotate it.
+// The new token is stored in the Secret and recorded in the status before the old one is revoked,
+// the tokens which could not be revoked are revoked on the next reconciliation.
+func (r *Reconcile) syncToken(ctx context.Context, instance *jenkinsApi.JenkinsAPIToken, jc jenkins.ClientInterface) error {
+	var schedule cron.Schedule
+
+	if instance.Spec.RotationSchedule != "" {
+		var err error
+
+		if schedule, err = cron.ParseStandard(instance.Spec.RotationSchedule); err != nil {
+			return fmt.Errorf("failed to parse rotation schedule %q: %w", instance.Spec.RotationSchedule, err)
+		}
+	}
+
+	if err := r.revokePendingTokens(ctx, instance, jc); err != nil {
+		return err
+	}
+
+	issueNeeded, err := r.isIssueNeeded(ctx, instance, schedule)
+	if err != nil {
+		return err
+	}
+
+	if !issueNeeded {
+		setNextRotationTime(instance, schedule)
+
+		return nil
+	}
+
+	token, err := jc.CreateAPIToken(instance.Spec.UserName, tokenName(instance))
+	if err != nil {
+		return fmt.Errorf("failed to create API token: %w", err)
+	}
+
+	if err = r.saveSecret(ctx, instance, token); err != nil {
+		// the value of the token is lost, so it is revoked instead of the current one.
+		instance.Status.PendingRevocation = append(instance.Status.PendingRevocation, jenkinsApi.ReplacedAPIToken{
+			TokenUUID: token.UUID,
+			UserName:  instance.Spec.UserName,
+		})
+
+		return err
+	}
+
+	if instance.Status.TokenUUID != "" {
+		instance.Status.PendingRevocation = append(instance.Status.PendingRevocation, jenkinsApi.ReplacedAPIToken{
+			TokenUUID:  instance.Status.TokenUUID,
+			UserName:   instance.Status.UserName,
+			SecretName: instance.Status.SecretName,
+		})
+	}
+
+	r.log.Info("API token has been issued", "user", instance.Spec.UserName, "secret", instance.Spec.SecretName)
+
+	instance.Status.TokenUUID = token.UUID
+	instance.Status.UserName = instance.Spec.UserName
+	instance.Status.SecretName = instance.Spec.SecretName
+	instance.Status.IssuedAt = &metav1.Time{Time: r.now()}
+	setNextRotationTime(instance, schedule)
+
+	return r.revokePendingTokens(ctx, instance, jc)
+}
+
+func (r *Reconcile) isIssueNeeded(ctx context.Context, instance *jenkinsApi.JenkinsAPIToken, schedule cron.Schedule) (bool, error) {
+	status := instance.Status
+
+	if status.TokenUUID == "" || status.IssuedAt == nil ||
+		status.UserName != instance.Spec.UserName || status.SecretName != instance.Spec.SecretName {
+		return true, nil
+	}
+
+	if schedule != nil && !schedule.Next(status.IssuedAt.Time).After(r.now()) {
+		return true, nil
+	}
+
+	err := r.client.Get(ctx, types.NamespacedName{Namespace: instance.Namespace, Name: instance.Spec.SecretName},
+		&corev1.Secret{})
+	if k8serrors.IsNotFound(err) {
+		return true, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("failed to get secret %s: %w", instance.Spec.SecretName, err)
+	}
+
+	return false, nil
+}
+
+func (r *Reconcile) saveSecret(ctx context.Context, instance *jenkinsApi.JenkinsAPIToken, token *jenkins.APIToken) error {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+		Name:      instance.Spec.SecretName,
+		Namespace: instance.Namespace,
+	}}
+
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.client, secret, func() error {
+		if secret.Data == nil {
+			secret.Data = make(map[string][]byte)
+		}
+
+		secret.Data[secretUserKey] = []byte(instance.Spec.UserName)
+		secret.Data[secretTokenKey] = []byte(token.Value)
+
+		if metav1.GetControllerOf(secret) != nil {
+			return nil
+		}
+
+		return controllerutil.SetControllerReference(instance, secret, r.client.Scheme())
+	}); err != nil {
+		return fmt.Errorf("failed to save secret %s: %w", secret.Name, err)
+	}
+
+	return nil
+}
+
+// revokePendingTokens revokes the replaced tokens and removes the Secrets they were stored in if they are not used anymore.
+// The revoked tokens are removed from the status one by one, so the revocation is resumed from the failed token.
+func (r *Reconcile) revokePendingTokens(ctx context.Context, instance *jenkinsApi.JenkinsAPIToken, jc jenkins.ClientInterface) error {
+	for len(instance.Status.PendingRevocation) > 0 {
+		token := instance.Status.PendingRevocation[0]
+
+		if err := jc.RevokeAPIToken(token.UserName, token.TokenUUID); err != nil {
+			return fmt.Errorf("failed to revoke API token %s: %w", token.TokenUUID, err)
+		}
+
+		if err := r.deleteSecret(ctx, instance, token.SecretName); err != nil {
+			return err
+		}
+
+		instance.Status.PendingRevocation = instance.Status.PendingRevocation[1:]
+	}
+
+	instance.Status.PendingRevocation = nil
+
+	return nil
+}
+
+// deleteSecret deletes the Secret controlled by the instance if it is not the one the token is stored in.
+func (r *Reconcile) deleteSecret(ctx context.Context, instance *jenkinsApi.JenkinsAPIToken, secretName string) error {
+	if secretName == "" || secretName == instance.Spec.SecretName {
+		return nil
+	}
+
+	secret := &corev1.Secret{}
+
+	err := r.client.Get(ctx, types.NamespacedName{Namespace: instance.Namespace, Name: secretName}, secret)
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to get secret %s: %w", secretName, err)
+	}
+
+	if !metav1.IsControlledBy(secret, instance) {
+		return nil
+	}
+
+	if err = r.client.Delete(ctx, secret); err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete secret %s: %w", secretName, err)
+	}
+
+	return nil
+}
+
+func (r *Reconcile) requeueAfter(instance *jenkinsApi.JenkinsAPIToken) time.Duration {
+	if instance.Status.NextRotationTime == nil {
+		return syncInterval
+	}
+
+	untilRotation := instance.Status.NextRotationTime.Sub(r.now())
+	if untilRotation < time.Second {
+		return time.Second
+	}
+
+	if untilRotation < syncInterval {
+		return untilRotation
+	}
+
+	return syncInterval
+}
+
+func setNextRotationTime(instance *jenkinsApi.JenkinsAPIToken, schedule cron.Schedule) {
+	if schedule == nil || instance.Status.IssuedAt == nil {
+		instance.Status.NextRotationTime = nil
+
+		return
+	}
+
+	instance.Status.NextRotationTime = &metav1.Time{Time: schedule.Next(instance.Status.IssuedAt.Time)}
+}
+
+func tokenName(instance *jenkinsApi.JenkinsAPIToken) string {
+	if instance.Spec.TokenName != "" {
+		return instance.Spec.TokenName
+	}
+
+	return instance.Name
+}
+
+func makeDeletionFunc(instance *jenkinsApi.JenkinsAPIToken, jc jenkins.ClientInterface) func() error {
+	return func() error {
+		// the Secret is garbage collected with the instance.
+		for _, token := range instance.Status.PendingRevocation {
+			if err := jc.RevokeAPIToken(token.UserName, token.TokenUUID); err != nil {
+				return fmt.Errorf("failed to revoke API token %s: %w", token.TokenUUID, err)
+			}
+		}
+
+		if instance.Status.TokenUUID == "" {
+			return nil
+		}
+
+		if err := jc.RevokeAPIToken(instance.Status.UserName, instance.Status.TokenUUID); err != nil {
+			return fmt.Errorf("failed to revoke API token: %w", err)
+		}
+
+		return nil
+	}
+}
+
+func (r *Reconcile) updateInstanceStatus(ctx context.Context, instance *jenkinsApi.JenkinsAPIToken, statusValue string) {
+	instance.Status.Value = statusValue
+
+	if err := r.client.Status().Update(ctx, instance); err != nil {
+		r.log.Error(err, "unable to update status", "instance", instance)
+	}
+}
@@ -0,0 +1,264 @@
+package jenkins_apitoken
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/client/jenkins"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/helper"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/helper/testhelper"
+)
+
+const (
+	name      = "sonar"
+	namespace = "ns"
+)
+
+var now = time.Date(2021, 6, 10, 12, 0, 0, 0, time.UTC)
+
+func getTestJenkinsAPIToken() *jenkinsApi.JenkinsAPIToken {
+	return &jenkinsApi.JenkinsAPIToken{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, UID: "uid"},
+		Spec: jenkinsApi.JenkinsAPITokenSpec{
+			UserName:   "ci",
+			SecretName: "sonar-jenkins-token",
+		},
+	}
+}
+
+func newTestReconcile(t *testing.T, jClient *jenkins.ClientMock, objects ...client.Object) (*Reconcile, client.Client) {
+	t.Helper()
+
+	k8sClient := testhelper.NewFakeClient(t, objects...)
+
+	return &Reconcile{
+		client:               k8sClient,
+		jenkinsClientFactory: testhelper.NewClientFactory(jClient),
+		log:                  &helper.LoggerMock{},
+		now:                  func() time.Time { return now },
+	}, k8sClient
+}
+
+func getSecret(t *testing.T, k8sClient client.Client, secretName string) *corev1.Secret {
+	t.Helper()
+
+	var secret corev1.Secret
+
+	require.NoError(t, k8sClient.Get(context.Background(),
+		types.NamespacedName{Namespace: namespace, Name: secretName}, &secret))
+
+	return &secret
+}
+
+func TestReconcile_Reconcile(t *testing.T) {
+	instance := getTestJenkinsAPIToken()
+
+	jClient := jenkins.ClientMock{}
+	jClient.On("CreateAPIToken", "ci", name).
+		Return(&jenkins.APIToken{UUID: "uuid", Name: name, Value: "token"}, nil)
+
+	r, k8sClient := newTestReconcile(t, &jClient, instance)
+
+	res := testhelper.Reconcile(t, r, namespace, name)
+	require.Equal(t, syncInterval, res.RequeueAfter)
+	jClient.AssertExpectations(t)
+
+	checkInstance := testhelper.Get[jenkinsApi.JenkinsAPIToken](t, k8sClient, namespace, name)
+	require.Equal(t, helper.StatusSuccess, checkInstance.Status.Value)
+	require.Equal(t, "uuid", checkInstance.Status.TokenUUID)
+	require.Equal(t, "ci", checkInstance.Status.UserName)
+	require.Nil(t, checkInstance.Status.NextRotationTime)
+
+	secret := getSecret(t, k8sClient, "sonar-jenkins-token")
+	require.Equal(t, "ci", string(secret.Data[secretUserKey]))
+	require.Equal(t, "token", string(secret.Data[secretTokenKey]))
+	require.True(t, metav1.IsControlledBy(secret, checkInstance))
+
+	// the token is not issued again while its Secret exists.
+	testhelper.Reconcile(t, r, namespace, name)
+	jClient.AssertNumberOfCalls(t, "CreateAPIToken", 1)
+}
+
+func TestReconcile_Reconcile_Rotation(t *testing.T) {
+	instance := getTestJenkinsAPIToken()
+	instance.Spec.RotationSchedule = "0 3 * * *"
+	instance.Status = jenkinsApi.JenkinsAPITokenStatus{
+		TokenUUID:  "old",
+		UserName:   "ci",
+		SecretName: "sonar-jenkins-token",
+		IssuedAt:   &metav1.Time{Time: now.Add(-24 * time.Hour)},
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "sonar-jenkins-token",
+			Namespace: namespace,
+			Labels:    map[string]string{"app": "sonar"},
+		},
+		Data: map[string][]byte{secretTokenKey: []byte("old-token")},
+	}
+
+	jClient := jenkins.ClientMock{}
+	jClient.On("CreateAPIToken", "ci", name).
+		Return(&jenkins.APIToken{UUID: "new", Name: name, Value: "new-token"}, nil)
+	jClient.On("RevokeAPIToken", "ci", "old").Return(nil)
+
+	r, k8sClient := newTestReconcile(t, &jClient, instance, secret)
+
+	res := testhelper.Reconcile(t, r, namespace, name)
+	require.Equal(t, syncInterval, res.RequeueAfter)
+	jClient.AssertExpectations(t)
+
+	checkInstance := testhelper.Get[jenkinsApi.JenkinsAPIToken](t, k8sClient, namespace, name)
+	require.Equal(t, "new", checkInstance.Status.TokenUUID)
+	require.Equal(t, time.Date(2021, 6, 11, 3, 0, 0, 0, time.UTC), checkInstance.Status.NextRotationTime.UTC())
+
+	checkSecret := getSecret(t, k8sClient, "sonar-jenkins-token")
+	require.Equal(t, "new-token", string(checkSecret.Data[secretTokenKey]))
+	require.Equal(t, "sonar", checkSecret.Labels["app"])
+}
+
+func TestReconcile_Reconcile_RotationRevokeErr(t *testing.T) {
+	instance := getTestJenkinsAPIToken()
+	instance.Finalizers = []string{finalizerName}
+	instance.Spec.RotationSchedule = "0 3 * * *"
+	instance.Status = jenkinsApi.JenkinsAPITokenStatus{
+		TokenUUID:  "old",
+		UserName:   "ci",
+		SecretName: "sonar-jenkins-token",
+		IssuedAt:   &metav1.Time{Time: now.Add(-24 * time.Hour)},
+	}
+
+	jClient := jenkins.ClientMock{}
+	jClient.On("CreateAPIToken", "ci", name).
+		Return(&jenkins.APIToken{UUID: "new", Name: name, Value: "new-token"}, nil).Once()
+	jClient.On("RevokeAPIToken", "ci", "old").Return(errors.New("revoke fatal")).Once()
+
+	r, k8sClient := newTestReconcile(t, &jClient, instance)
+
+	res := testhelper.Reconcile(t, r, namespace, name)
+	require.Equal(t, helper.DefaultRequeueTime*time.Second, res.RequeueAfter)
+	jClient.AssertExpectations(t)
+
+	// the new token is recorded, the old one is still to be revoked.
+	checkInstance := testhelper.Get[jenkinsApi.JenkinsAPIToken](t, k8sClient, namespace, name)
+	require.Equal(t, "failed to revoke API token old: revoke fatal", checkInstance.Status.Value)
+	require.Equal(t, "new", checkInstance.Status.TokenUUID)
+	require.Equal(t, []jenkinsApi.ReplacedAPIToken{
+		{TokenUUID: "old", UserName: "ci", SecretName: "sonar-jenkins-token"},
+	}, checkInstance.Status.PendingRevocation)
+	require.Equal(t, "new-token", string(getSecret(t, k8sClient, "sonar-jenkins-token").Data[secretTokenKey]))
+
+	jClient.On("RevokeAPIToken", "ci", "old").Return(nil).Once()
+
+	res = testhelper.Reconcile(t, r, namespace, name)
+	require.Equal(t, syncInterval, res.RequeueAfter)
+	jClient.AssertExpectations(t)
+	jClient.AssertNumberOfCalls(t, "CreateAPIToken", 1)
+
+	checkInstance = testhelper.Get[jenkinsApi.JenkinsAPIToken](t, k8sClient, namespace, name)
+	require.Equal(t, helper.StatusSuccess, checkInstance.Status.Value)
+	require.Equal(t, "new", checkInstance.Status.TokenUUID)
+	require.Empty(t, checkInstance.Status.PendingRevocation)
+}
+
+func TestReconcile_Reconcile_RotationNotDue(t *testing.T) {
+	instance := getTestJenkinsAPIToken()
+	instance.Spec.RotationSchedule = "0 13 * * *"
+	instance.Status = jenkinsApi.JenkinsAPITokenStatus{
+		TokenUUID:  "uuid",
+		UserName:   "ci",
+		SecretName: "sonar-jenkins-token",
+		IssuedAt:   &metav1.Time{Time: now.Add(-time.Hour)},
+	}
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "sonar-jenkins-token", Namespace: namespace}}
+
+	jClient := jenkins.ClientMock{}
+	r, _ := newTestReconcile(t, &jClient, instance, secret)
+
+	res := testhelper.Reconcile(t, r, namespace, name)
+	require.Equal(t, syncInterval, res.RequeueAfter)
+	jClient.AssertNotCalled(t, "CreateAPIToken", "ci", name)
+
+	instance.Spec.RotationSchedule = "5 12 * * *"
+	r, _ = newTestReconcile(t, &jClient, instance, secret)
+
+	res = testhelper.Reconcile(t, r, namespace, name)
+	require.Equal(t, 5*time.Minute, res.RequeueAfter)
+}
+
+func TestReconcile_Reconcile_SecretRenamed(t *testing.T) {
+	instance := getTestJenkinsAPIToken()
+	instance.Status = jenkinsApi.JenkinsAPITokenStatus{
+		TokenUUID:  "old",
+		UserName:   "ci",
+		SecretName: "old-secret",
+		IssuedAt:   &metav1.Time{Time: now},
+	}
+
+	ownerRef := metav1.NewControllerRef(instance, jenkinsApi.SchemeGroupVersion.WithKind("JenkinsAPIToken"))
+	oldSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+		Name:            "old-secret",
+		Namespace:       namespace,
+		OwnerReferences: []metav1.OwnerReference{*ownerRef},
+	}}
+
+	jClient := jenkins.ClientMock{}
+	jClient.On("CreateAPIToken", "ci", name).
+		Return(&jenkins.APIToken{UUID: "new", Name: name, Value: "token"}, nil)
+	jClient.On("RevokeAPIToken", "ci", "old").Return(nil)
+
+	r, k8sClient := newTestReconcile(t, &jClient, instance, oldSecret)
+
+	testhelper.Reconcile(t, r, namespace, name)
+	jClient.AssertExpectations(t)
+
+	err := k8sClient.Get(context.Background(), types.NamespacedName{Namespace: namespace, Name: "old-secret"},
+		&corev1.Secret{})
+	require.True(t, k8serrors.IsNotFound(err))
+
+	checkInstance := testhelper.Get[jenkinsApi.JenkinsAPIToken](t, k8sClient, namespace, name)
+	require.Equal(t, "sonar-jenkins-token", checkInstance.Status.SecretName)
+}
+
+func TestReconcile_Reconcile_InvalidSchedule(t *testing.T) {
+	instance := getTestJenkinsAPIToken()
+	instance.Spec.RotationSchedule = "monthly"
+
+	jClient := jenkins.ClientMock{}
+	r, k8sClient := newTestReconcile(t, &jClient, instance)
+
+	res := testhelper.Reconcile(t, r, namespace, name)
+	require.Equal(t, helper.DefaultRequeueTime*time.Second, res.RequeueAfter)
+
+	checkInstance := testhelper.Get[jenkinsApi.JenkinsAPIToken](t, k8sClient, namespace, name)
+	require.Contains(t, checkInstance.Status.Value, "failed to parse rotation schedule")
+}
+
+func TestReconcile_Reconcile_Delete(t *testing.T) {
+	instance := getTestJenkinsAPIToken()
+	instance.Finalizers = []string{finalizerName}
+	instance.DeletionTimestamp = &metav1.Time{Time: now}
+	instance.Status = jenkinsApi.JenkinsAPITokenStatus{TokenUUID: "uuid", UserName: "ci"}
+
+	jClient := jenkins.ClientMock{}
+	jClient.On("RevokeAPIToken", "ci", "uuid").Return(nil)
+
+	r, k8sClient := newTestReconcile(t, &jClient, instance)
+
+	res := testhelper.Reconcile(t, r, namespace, name)
+	require.Zero(t, res.RequeueAfter)
+	jClient.AssertExpectations(t)
+	require.Empty(t, testhelper.Get[jenkinsApi.JenkinsAPIToken](t, k8sClient, namespace, name).Finalizers)
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sort"
 	"time"
 
 	"github.com/go-logr/logr"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -18,15 +20,22 @@ import (
 	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
 	"github.com/epam/edp-jenkins-operator/v2/pkg/client/jenkins"
 	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/helper"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/service/authorization"
 	"github.com/epam/edp-jenkins-operator/v2/pkg/service/platform"
 )
 
-const finalizerName = "jenkinsauthrole.jenkins.finalizer.name"
+const (
+	finalizerName = "jenkinsauthrole.jenkins.finalizer.name"
+
+	// driftCheckInterval is how often the role is compared with Jenkins to revert manual changes made in the UI.
+	driftCheckInterval = 10 * time.Minute
+)
 
 type Reconcile struct {
 	client               client.Client
 	log                  logr.Logger
 	jenkinsClientFactory jenkins.ClientFactory
+	permissions          *authorization.PermissionCache
 }
 
 func NewReconciler(k8sCl client.Client, logf logr.Logger, ps platform.PlatformService) *Reconcile {
@@ -34,6 +43,7 @@ func NewReconciler(k8sCl client.Client, logf logr.Logger, ps platform.PlatformSe
 		client:               k8sCl,
 		log:                  logf.WithName("controller_jenkins_authorizationrole"),
 		jenkinsClientFactory: jenkins.MakeClientBuilder(ps, k8sCl),
+		permissions:          authorization.NewPermissionCache(authorization.PermissionCacheTTL),
 	}
 }
 
@@ -99,7 +109,11 @@ func (r *Reconcile) Reconcile(ctx context.Context, request reconcile.Request) (r
 
 	reqLogger.Info("Reconciling JenkinsAuthorizationRole has been finished")
 
-	return reconcile.Result{}, nil
+	if !instance.GetDeletionTimestamp().IsZero() {
+		return reconcile.Result{}, nil
+	}
+
+	return reconcile.Result{RequeueAfter: driftCheckInterval}, nil
 }
 
 func (r *Reconcile) tryToReconcile(
@@ -107,8 +121,14 @@ func (r *Reconcile) tryToReconcile(
 	instance *jenkinsApi.JenkinsAuthorizationRole,
 	jc jenkins.ClientInterface,
 ) error {
-	if err := jc.AddRole(instance.Spec.RoleType, instance.Spec.Name, instance.Spec.Pattern, instance.Spec.Permissions); err != nil {
-		return fmt.Errorf("failed to add role: %w", err)
+	if instance.GetDeletionTimestamp().IsZero() {
+		if err := r.validateRole(instance, jc); err != nil {
+			return err
+		}
+
+		if err := r.syncRole(instance, jc); err != nil {
+			return err
+		}
 	}
 
 	updateNeeded, err := helper.TryToDelete(instance, finalizerName, makeDeletionFunc(instance, jc))
@@ -117,14 +137,102 @@ func (r *Reconcile) tryToReconcile(
 	}
 
 	if updateNeeded {
-		if err := r.client.Update(ctx, instance); err != nil {
-			return fmt.Errorf("failed to update instance: %w", err)
+		return helper.UpdateKeepingStatus(ctx, r.client, instance, &instance.Status)
+	}
+
+	return nil
+}
+
+// validateRole checks the role spec before it is applied and reports invalid entries in the status.
+func (r *Reconcile) validateRole(instance *jenkinsApi.JenkinsAuthorizationRole, jc jenkins.ClientInterface) error {
+	known, err := r.permissions.Get(authorization.CacheKey(instance.Namespace, instance.Spec.OwnerName), jc)
+	if err != nil {
+		return fmt.Errorf("failed to validate role: %w", err)
+	}
+
+	result := authorization.ValidateRole(&instance.Spec, known)
+
+	instance.Status.InvalidPermissions = result.InvalidPermissions
+	instance.Status.PatternError = result.PatternError
+
+	if !result.IsValid() {
+		return fmt.Errorf("invalid role spec: %s", result.String())
+	}
+
+	return nil
+}
+
+// syncRole creates the role in Jenkins or overwrites it when it has drifted from the spec.
+func (r *Reconcile) syncRole(instance *jenkinsApi.JenkinsAuthorizationRole, jc jenkins.ClientInterface) error {
+	spec := instance.Spec
+
+	if isRoleRenamed(instance) {
+		if err := jc.RemoveRoles(instance.Status.RoleType, []string{instance.Status.RoleName}); err != nil {
+			return fmt.Errorf("failed to remove old role %s: %w", instance.Status.RoleName, err)
 		}
 	}
 
+	role, err := jc.GetRole(spec.RoleType, spec.Name)
+
+	switch {
+	case jenkins.IsErrNotFound(err):
+		if err = jc.AddRole(spec.RoleType, spec.Name, spec.Pattern, spec.Permissions); err != nil {
+			return fmt.Errorf("failed to add role: %w", err)
+		}
+	case err != nil:
+		return fmt.Errorf("failed to get role: %w", err)
+	case roleDrifted(role, &spec):
+		r.log.Info("role differs from spec, overwriting", "role", spec.Name)
+
+		if err = jc.UpdateRole(spec.RoleType, spec.Name, spec.Pattern, spec.Permissions); err != nil {
+			return fmt.Errorf("failed to update role: %w", err)
+		}
+
+		// Jenkins drops assignments of the overwritten role, restore them.
+		for _, sid := range role.SIDs {
+			if err = jc.AssignRole(spec.RoleType, spec.Name, sid); err != nil {
+				return fmt.Errorf("failed to restore assignment of role to %s: %w", sid, err)
+			}
+		}
+	}
+
+	instance.Status.RoleName = spec.Name
+	instance.Status.RoleType = spec.RoleType
+	instance.Status.LastSyncTime = &metav1.Time{Time: time.Now()}
+
 	return nil
 }
 
+func isRoleRenamed(instance *jenkinsApi.JenkinsAuthorizationRole) bool {
+	return instance.Status.RoleName != "" &&
+		(instance.Status.RoleName != instance.Spec.Name || instance.Status.RoleType != instance.Spec.RoleType)
+}
+
+func roleDrifted(role *jenkins.Role, spec *jenkinsApi.JenkinsAuthorizationRoleSpec) bool {
+	if role.Pattern != spec.Pattern {
+		return true
+	}
+
+	permissions := make([]string, len(spec.Permissions))
+	copy(permissions, spec.Permissions)
+	sort.Strings(permissions)
+
+	return !reflect.DeepEqual(role.GrantedPermissions(), uniqueStrings(permissions))
+}
+
+// uniqueStrings removes duplicates from the sorted slice.
+func uniqueStrings(sorted []string) []string {
+	result := make([]string, 0, len(sorted))
+
+	for i, s := range sorted {
+		if i == 0 || sorted[i-1] != s {
+			result = append(result, s)
+		}
+	}
+
+	return result
+}
+
 func makeDeletionFunc(instance *jenkinsApi.JenkinsAuthorizationRole,
 	jc jenkins.ClientInterface,
 ) func() error {
@@ -133,6 +241,12 @@ func makeDeletionFunc(instance *jenkinsApi.JenkinsAuthorizationRole,
 			return fmt.Errorf("failed to delete role: %w", err)
 		}
 
+		if isRoleRenamed(instance) {
+			if err := jc.RemoveRoles(instance.Status.RoleType, []string{instance.Status.RoleName}); err != nil {
+				return fmt.Errorf("failed to delete old role %s: %w", instance.Status.RoleName, err)
+			}
+		}
+
 		return nil
 	}
 }
@@ -7,10 +7,9 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
-	v1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -19,6 +18,8 @@ import (
 	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
 	"github.com/epam/edp-jenkins-operator/v2/pkg/client/jenkins"
 	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/helper"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/helper/testhelper"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/service/authorization"
 )
 
 func getTestJenkinsAuthorizationRole() *jenkinsApi.JenkinsAuthorizationRole {
@@ -27,10 +28,6 @@ func getTestJenkinsAuthorizationRole() *jenkinsApi.JenkinsAuthorizationRole {
 			Name:      "test",
 			Namespace: "nss",
 		},
-		TypeMeta: metav1.TypeMeta{
-			Kind:       "JenkinsAuthorizationRole",
-			APIVersion: "apps/v1",
-		},
 		Spec: jenkinsApi.JenkinsAuthorizationRoleSpec{
 			Name:        "test",
 			RoleType:    "rt",
@@ -43,20 +40,126 @@ func getTestJenkinsAuthorizationRole() *jenkinsApi.JenkinsAuthorizationRole {
 func TestReconcile_Reconcile(t *testing.T) {
 	jar := getTestJenkinsAuthorizationRole()
 
-	s := scheme.Scheme
-	s.AddKnownTypes(v1.SchemeGroupVersion, jar)
+	k8sClient := testhelper.NewFakeClient(t, jar)
+	jClient := jenkins.ClientMock{}
+	jBuilder := jenkins.ClientBuilderMock{}
+	jBuilder.On("MakeNewClient", jar.Spec.OwnerName).Return(&jClient, nil)
+	jClient.On("GetPermissionIDs").Return(jar.Spec.Permissions, nil)
+
+	jClient.On("GetRole", jar.Spec.RoleType, jar.Spec.Name).Return(nil, jenkins.ErrNotFound)
+	jClient.On("AddRole", jar.Spec.RoleType, jar.Spec.Name, jar.Spec.Pattern, jar.Spec.Permissions).
+		Return(nil)
+
+	r := Reconcile{
+		client:               k8sClient,
+		jenkinsClientFactory: &jBuilder,
+		permissions:          authorization.NewPermissionCache(time.Minute),
+		log:                  &helper.LoggerMock{},
+	}
+
+	req := reconcile.Request{
+		NamespacedName: types.NamespacedName{Namespace: jar.Namespace, Name: jar.Name},
+	}
+
+	res, err := r.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, driftCheckInterval, res.RequeueAfter)
+	jClient.AssertExpectations(t)
+
+	checkInstance := getInstance(t, k8sClient, jar)
+	require.Equal(t, helper.StatusSuccess, checkInstance.Status.Value)
+	require.Equal(t, jar.Spec.Name, checkInstance.Status.RoleName)
+	require.Equal(t, jar.Spec.RoleType, checkInstance.Status.RoleType)
+	require.NotNil(t, checkInstance.Status.LastSyncTime)
+}
+
+func TestReconcile_Reconcile_NoDrift(t *testing.T) {
+	jar := getTestJenkinsAuthorizationRole()
+
+	k8sClient := testhelper.NewFakeClient(t, jar)
+	jClient := jenkins.ClientMock{}
+	jBuilder := jenkins.ClientBuilderMock{}
+	jBuilder.On("MakeNewClient", jar.Spec.OwnerName).Return(&jClient, nil)
+	jClient.On("GetPermissionIDs").Return(jar.Spec.Permissions, nil)
+
+	jClient.On("GetRole", jar.Spec.RoleType, jar.Spec.Name).Return(&jenkins.Role{
+		PermissionIDs: map[string]bool{"bat": true, "foo": true, "baz": false},
+		Pattern:       jar.Spec.Pattern,
+		SIDs:          []string{"user"},
+	}, nil)
+
+	r := Reconcile{
+		client:               k8sClient,
+		jenkinsClientFactory: &jBuilder,
+		permissions:          authorization.NewPermissionCache(time.Minute),
+		log:                  &helper.LoggerMock{},
+	}
+
+	req := reconcile.Request{
+		NamespacedName: types.NamespacedName{Namespace: jar.Namespace, Name: jar.Name},
+	}
+
+	_, err := r.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+	jClient.AssertExpectations(t)
+	jClient.AssertNotCalled(t, "UpdateRole", jar.Spec.RoleType, jar.Spec.Name, jar.Spec.Pattern, jar.Spec.Permissions)
+}
+
+func TestReconcile_Reconcile_Drift(t *testing.T) {
+	jar := getTestJenkinsAuthorizationRole()
+
+	k8sClient := testhelper.NewFakeClient(t, jar)
+	jClient := jenkins.ClientMock{}
+	jBuilder := jenkins.ClientBuilderMock{}
+	jBuilder.On("MakeNewClient", jar.Spec.OwnerName).Return(&jClient, nil)
+	jClient.On("GetPermissionIDs").Return(jar.Spec.Permissions, nil)
+
+	jClient.On("GetRole", jar.Spec.RoleType, jar.Spec.Name).Return(&jenkins.Role{
+		PermissionIDs: map[string]bool{"foo": true, "manual": true},
+		Pattern:       jar.Spec.Pattern,
+		SIDs:          []string{"user1", "user2"},
+	}, nil)
+	jClient.On("UpdateRole", jar.Spec.RoleType, jar.Spec.Name, jar.Spec.Pattern, jar.Spec.Permissions).
+		Return(nil)
+	jClient.On("AssignRole", jar.Spec.RoleType, jar.Spec.Name, "user1").Return(nil)
+	jClient.On("AssignRole", jar.Spec.RoleType, jar.Spec.Name, "user2").Return(nil)
+
+	r := Reconcile{
+		client:               k8sClient,
+		jenkinsClientFactory: &jBuilder,
+		permissions:          authorization.NewPermissionCache(time.Minute),
+		log:                  &helper.LoggerMock{},
+	}
+
+	req := reconcile.Request{
+		NamespacedName: types.NamespacedName{Namespace: jar.Namespace, Name: jar.Name},
+	}
+
+	_, err := r.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+	jClient.AssertExpectations(t)
+}
+
+func TestReconcile_Reconcile_Rename(t *testing.T) {
+	jar := getTestJenkinsAuthorizationRole()
+	jar.Status.RoleName = "old-name"
+	jar.Status.RoleType = jar.Spec.RoleType
 
-	k8sClient := fake.NewClientBuilder().WithRuntimeObjects(jar).Build()
+	k8sClient := testhelper.NewFakeClient(t, jar)
 	jClient := jenkins.ClientMock{}
 	jBuilder := jenkins.ClientBuilderMock{}
 	jBuilder.On("MakeNewClient", jar.Spec.OwnerName).Return(&jClient, nil)
+	jClient.On("GetPermissionIDs").Return(jar.Spec.Permissions, nil)
 
+	jClient.On("RemoveRoles", jar.Spec.RoleType, []string{"old-name"}).Return(nil)
+	jClient.On("GetRole", jar.Spec.RoleType, jar.Spec.Name).Return(nil, jenkins.ErrNotFound)
 	jClient.On("AddRole", jar.Spec.RoleType, jar.Spec.Name, jar.Spec.Pattern, jar.Spec.Permissions).
 		Return(nil)
 
 	r := Reconcile{
 		client:               k8sClient,
 		jenkinsClientFactory: &jBuilder,
+		permissions:          authorization.NewPermissionCache(time.Minute),
 		log:                  &helper.LoggerMock{},
 	}
 
@@ -66,19 +169,71 @@ func TestReconcile_Reconcile(t *testing.T) {
 
 	_, err := r.Reconcile(context.Background(), req)
 	require.NoError(t, err)
+	jClient.AssertExpectations(t)
+
+	require.Equal(t, jar.Spec.Name, getInstance(t, k8sClient, jar).Status.RoleName)
+}
+
+func TestReconcile_Reconcile_RenamedAfterCreation(t *testing.T) {
+	jar := getTestJenkinsAuthorizationRole()
+	k8sClient := testhelper.NewFakeClient(t, jar)
+	jClient := jenkins.ClientMock{}
+	jClient.On("GetPermissionIDs").Return(jar.Spec.Permissions, nil)
+	jClient.On("GetRole", jar.Spec.RoleType, jar.Spec.Name).Return(nil, jenkins.ErrNotFound)
+	jClient.On("AddRole", jar.Spec.RoleType, jar.Spec.Name, jar.Spec.Pattern, jar.Spec.Permissions).
+		Return(nil)
+
+	r := Reconcile{
+		client:               k8sClient,
+		jenkinsClientFactory: testhelper.NewClientFactory(&jClient),
+		permissions:          authorization.NewPermissionCache(time.Minute),
+		log:                  &helper.LoggerMock{},
+	}
+
+	testhelper.Reconcile(t, &r, jar.Namespace, jar.Name)
+
+	// the synced role is kept in the status across the finalizer update.
+	checkInstance := getInstance(t, k8sClient, jar)
+	require.Equal(t, []string{finalizerName}, checkInstance.GetFinalizers())
+	require.Equal(t, jar.Spec.Name, checkInstance.Status.RoleName)
+	require.NotNil(t, checkInstance.Status.LastSyncTime)
+
+	checkInstance.Spec.Name = "new-name"
+	require.NoError(t, k8sClient.Update(context.Background(), checkInstance))
+
+	jClient.On("RemoveRoles", jar.Spec.RoleType, []string{jar.Spec.Name}).Return(nil)
+	jClient.On("GetRole", jar.Spec.RoleType, "new-name").Return(nil, jenkins.ErrNotFound)
+	jClient.On("AddRole", jar.Spec.RoleType, "new-name", jar.Spec.Pattern, jar.Spec.Permissions).Return(nil)
+
+	testhelper.Reconcile(t, &r, jar.Namespace, jar.Name)
+	jClient.AssertExpectations(t)
+	require.Equal(t, "new-name", getInstance(t, k8sClient, jar).Status.RoleName)
+}
+
+func getInstance(
+	t *testing.T,
+	k8sClient client.Client,
+	jar *jenkinsApi.JenkinsAuthorizationRole,
+) *jenkinsApi.JenkinsAuthorizationRole {
+	t.Helper()
+
+	var checkInstance jenkinsApi.JenkinsAuthorizationRole
+
+	require.NoError(t, k8sClient.Get(context.Background(),
+		types.NamespacedName{Namespace: jar.Namespace, Name: jar.Name}, &checkInstance))
+
+	return &checkInstance
 }
 
 func TestReconcile_Reconcile_Delete(t *testing.T) {
 	jar := getTestJenkinsAuthorizationRole()
 	jar.DeletionTimestamp = &metav1.Time{Time: time.Now()}
 
-	s := scheme.Scheme
-	s.AddKnownTypes(v1.SchemeGroupVersion, jar)
-
-	k8sClient := fake.NewClientBuilder().WithRuntimeObjects(jar).Build()
+	k8sClient := testhelper.NewFakeClient(t, jar)
 	jClient := jenkins.ClientMock{}
 	jBuilder := jenkins.ClientBuilderMock{}
 	jBuilder.On("MakeNewClient", jar.Spec.OwnerName).Return(&jClient, nil)
+	jClient.On("GetPermissionIDs").Return(jar.Spec.Permissions, nil)
 
 	jClient.On("AddRole", jar.Spec.RoleType, jar.Spec.Name, jar.Spec.Pattern, jar.Spec.Permissions).
 		Return(nil)
@@ -87,6 +242,7 @@ func TestReconcile_Reconcile_Delete(t *testing.T) {
 	r := Reconcile{
 		client:               k8sClient,
 		jenkinsClientFactory: &jBuilder,
+		permissions:          authorization.NewPermissionCache(time.Minute),
 		log:                  &helper.LoggerMock{},
 	}
 
@@ -116,13 +272,11 @@ func TestReconcile_Reconcile_Delete_FailureRemoveRoles(t *testing.T) {
 	jar := getTestJenkinsAuthorizationRole()
 	jar.DeletionTimestamp = &metav1.Time{Time: time.Now()}
 
-	s := scheme.Scheme
-	s.AddKnownTypes(v1.SchemeGroupVersion, jar)
-
-	k8sClient := fake.NewClientBuilder().WithRuntimeObjects(jar).Build()
+	k8sClient := testhelper.NewFakeClient(t, jar)
 	jClient := jenkins.ClientMock{}
 	jBuilder := jenkins.ClientBuilderMock{}
 	jBuilder.On("MakeNewClient", jar.Spec.OwnerName).Return(&jClient, nil)
+	jClient.On("GetPermissionIDs").Return(jar.Spec.Permissions, nil)
 
 	jClient.On("AddRole", jar.Spec.RoleType, jar.Spec.Name, jar.Spec.Pattern, jar.Spec.Permissions).Return(nil)
 	jClient.On("RemoveRoles", jar.Spec.RoleType, []string{jar.Spec.Name}).Return(errors.New("remove roles failure"))
@@ -130,6 +284,7 @@ func TestReconcile_Reconcile_Delete_FailureRemoveRoles(t *testing.T) {
 	r := Reconcile{
 		client:               k8sClient,
 		jenkinsClientFactory: &jBuilder,
+		permissions:          authorization.NewPermissionCache(time.Minute),
 		log:                  &helper.LoggerMock{},
 	}
 
@@ -174,10 +329,7 @@ func TestNewReconciler(t *testing.T) {
 }
 
 func TestReconcile_Reconcile_FailureNotFound(t *testing.T) {
-	s := scheme.Scheme
-	s.AddKnownTypes(v1.SchemeGroupVersion, &jenkinsApi.JenkinsAuthorizationRole{})
-
-	k8sClient := fake.NewClientBuilder().Build()
+	k8sClient := testhelper.NewFakeClient(t)
 	logger := helper.LoggerMock{}
 	r := Reconcile{
 		client: k8sClient,
@@ -200,10 +352,7 @@ func TestReconcile_Reconcile_FailureNotFound(t *testing.T) {
 func TestReconcile_Reconcile_FailureInitJenkinsClient(t *testing.T) {
 	jar := getTestJenkinsAuthorizationRole()
 
-	s := scheme.Scheme
-	s.AddKnownTypes(v1.SchemeGroupVersion, jar)
-
-	k8sClient := fake.NewClientBuilder().WithRuntimeObjects(jar).Build()
+	k8sClient := testhelper.NewFakeClient(t, jar)
 	jBuilder := jenkins.ClientBuilderMock{}
 	jBuilder.On("MakeNewClient", jar.Spec.OwnerName).
 		Return(nil, errors.New("make new client fatal"))
@@ -212,6 +361,7 @@ func TestReconcile_Reconcile_FailureInitJenkinsClient(t *testing.T) {
 	r := Reconcile{
 		client:               k8sClient,
 		jenkinsClientFactory: &jBuilder,
+		permissions:          authorization.NewPermissionCache(time.Minute),
 		log:                  &logger,
 	}
 
@@ -228,14 +378,13 @@ func TestReconcile_Reconcile_FailureInitJenkinsClient(t *testing.T) {
 func TestReconcile_Reconcile_FailureAddRole(t *testing.T) {
 	jar := getTestJenkinsAuthorizationRole()
 
-	s := scheme.Scheme
-	s.AddKnownTypes(v1.SchemeGroupVersion, jar)
-
-	k8sClient := fake.NewClientBuilder().WithRuntimeObjects(jar).Build()
+	k8sClient := testhelper.NewFakeClient(t, jar)
 	jClient := jenkins.ClientMock{}
 	jBuilder := jenkins.ClientBuilderMock{}
 	jBuilder.On("MakeNewClient", jar.Spec.OwnerName).Return(&jClient, nil)
+	jClient.On("GetPermissionIDs").Return(jar.Spec.Permissions, nil)
 
+	jClient.On("GetRole", jar.Spec.RoleType, jar.Spec.Name).Return(nil, jenkins.ErrNotFound)
 	jClient.On("AddRole", jar.Spec.RoleType, jar.Spec.Name, jar.Spec.Pattern, jar.Spec.Permissions).
 		Return(errors.New("add role fatal"))
 
@@ -244,6 +393,7 @@ func TestReconcile_Reconcile_FailureAddRole(t *testing.T) {
 	r := Reconcile{
 		client:               k8sClient,
 		jenkinsClientFactory: &jBuilder,
+		permissions:          authorization.NewPermissionCache(time.Minute),
 		log:                  &logger,
 	}
 
@@ -259,3 +409,64 @@ func TestReconcile_Reconcile_FailureAddRole(t *testing.T) {
 
 	require.Contains(t, lastErr.Error(), "add role fatal")
 }
+
+func TestReconcile_Reconcile_InvalidSpec(t *testing.T) {
+	jar := getTestJenkinsAuthorizationRole()
+	jar.Spec.Permissions = append(jar.Spec.Permissions, "typo")
+	jar.Spec.Pattern = "(regex"
+
+	k8sClient := testhelper.NewFakeClient(t, jar)
+	jClient := jenkins.ClientMock{}
+	jBuilder := jenkins.ClientBuilderMock{}
+	jBuilder.On("MakeNewClient", jar.Spec.OwnerName).Return(&jClient, nil)
+	jClient.On("GetPermissionIDs").Return([]string{"foo", "bat"}, nil)
+
+	r := Reconcile{
+		client:               k8sClient,
+		jenkinsClientFactory: &jBuilder,
+		permissions:          authorization.NewPermissionCache(time.Minute),
+		log:                  &helper.LoggerMock{},
+	}
+
+	req := reconcile.Request{
+		NamespacedName: types.NamespacedName{Namespace: jar.Namespace, Name: jar.Name},
+	}
+
+	res, err := r.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, helper.DefaultRequeueTime*time.Second, res.RequeueAfter)
+	jClient.AssertNotCalled(t, "GetRole", jar.Spec.RoleType, jar.Spec.Name)
+
+	checkInstance := getInstance(t, k8sClient, jar)
+	require.Contains(t, checkInstance.Status.Value, "invalid role spec")
+	require.Equal(t, []string{"typo"}, checkInstance.Status.InvalidPermissions)
+	require.Contains(t, checkInstance.Status.PatternError, "invalid pattern")
+}
+
+func TestReconcile_Reconcile_FixedSpec(t *testing.T) {
+	jar := getTestJenkinsAuthorizationRole()
+	jar.Status.InvalidPermissions = []string{"typo"}
+	jar.Status.PatternError = "invalid pattern"
+
+	k8sClient := testhelper.NewFakeClient(t, jar)
+	jClient := jenkins.ClientMock{}
+	jClient.On("GetPermissionIDs").Return(jar.Spec.Permissions, nil)
+	jClient.On("GetRole", jar.Spec.RoleType, jar.Spec.Name).Return(nil, jenkins.ErrNotFound)
+	jClient.On("AddRole", jar.Spec.RoleType, jar.Spec.Name, jar.Spec.Pattern, jar.Spec.Permissions).
+		Return(nil)
+
+	r := Reconcile{
+		client:               k8sClient,
+		jenkinsClientFactory: testhelper.NewClientFactory(&jClient),
+		permissions:          authorization.NewPermissionCache(time.Minute),
+		log:                  &helper.LoggerMock{},
+	}
+
+	testhelper.Reconcile(t, &r, jar.Namespace, jar.Name)
+
+	// the validation result of the first reconciliation is kept across the finalizer update.
+	checkInstance := getInstance(t, k8sClient, jar)
+	require.Equal(t, helper.StatusSuccess, checkInstance.Status.Value)
+	require.Empty(t, checkInstance.Status.InvalidPermissions)
+	require.Empty(t, checkInstance.Status.PatternError)
+}
@@ -109,9 +109,9 @@ func (r *Reconcile) tryToReconcile(
 	instance *jenkinsApi.JenkinsAuthorizationRoleMapping,
 	jenkinsClient jenkins.ClientInterface,
 ) error {
-	for _, rl := range instance.Spec.Roles {
-		if err := jenkinsClient.AssignRole(instance.Spec.RoleType, rl, instance.Spec.Group); err != nil {
-			return fmt.Errorf("failed to assign role: %w", err)
+	if instance.GetDeletionTimestamp().IsZero() {
+		if err := syncAssignments(instance, jenkinsClient); err != nil {
+			return err
 		}
 	}
 
@@ -124,19 +124,23 @@ func (r *Reconcile) tryToReconcile(
 		return nil
 	}
 
-	if err := r.client.Update(ctx, instance); err != nil {
-		return fmt.Errorf("failed to update instance: %w", err)
-	}
-
-	return nil
+	return helper.UpdateKeepingStatus(ctx, r.client, instance, &instance.Status)
 }
 
 func makeDeletionFunc(instance *jenkinsApi.JenkinsAuthorizationRoleMapping,
 	jc jenkins.ClientInterface,
 ) func() error {
 	return func() error {
-		for _, rl := range instance.Spec.Roles {
-			if err := jc.UnAssignRole(instance.Spec.RoleType, rl, instance.Spec.Group); err != nil {
+		assignments := instance.Status.Assigned
+
+		for _, a := range desiredAssignments(instance) {
+			if !containsAssignment(assignments, a) {
+				assignments = append(assignments, a)
+			}
+		}
+
+		for _, a := range assignments {
+			if err := jc.UnAssignRole(a.RoleType, a.Role, a.Group); err != nil {
 				return fmt.Errorf("failed to unassign role: %w", err)
 			}
 		}
@@ -145,6 +149,99 @@ func makeDeletionFunc(instance *jenkinsApi.JenkinsAuthorizationRoleMapping,
 	}
 }
 
+// syncAssignments unassigns roles which are remembered in the status but no longer desired
+// and assigns the new ones. Every role is processed even if some of them fail.
+func syncAssignments(instance *jenkinsApi.JenkinsAuthorizationRoleMapping, jc jenkins.ClientInterface) error {
+	desired := desiredAssignments(instance)
+	assigned := make([]jenkinsApi.RoleAssignment, 0, len(desired))
+	results := make([]jenkinsApi.RoleAssignmentResult, 0, len(desired))
+	failed := 0
+
+	for _, a := range instance.Status.Assigned {
+		if containsAssignment(desired, a) {
+			assigned = append(assigned, a)
+
+			continue
+		}
+
+		err := jc.UnAssignRole(a.RoleType, a.Role, a.Group)
+		if err != nil {
+			failed++
+
+			assigned = append(assigned, a)
+		}
+
+		results = append(results, makeResult(a, jenkinsApi.RoleMappingActionUnassign, err))
+	}
+
+	for _, a := range desired {
+		if containsAssignment(assigned, a) {
+			continue
+		}
+
+		err := jc.AssignRole(a.RoleType, a.Role, a.Group)
+		if err != nil {
+			failed++
+		} else {
+			assigned = append(assigned, a)
+		}
+
+		results = append(results, makeResult(a, jenkinsApi.RoleMappingActionAssign, err))
+	}
+
+	instance.Status.Assigned = assigned
+	instance.Status.Roles = results
+
+	if failed > 0 {
+		return fmt.Errorf("failed to sync %d of %d role assignments, see status.roles for details", failed, len(results))
+	}
+
+	return nil
+}
+
+func desiredAssignments(instance *jenkinsApi.JenkinsAuthorizationRoleMapping) []jenkinsApi.RoleAssignment {
+	desired := make([]jenkinsApi.RoleAssignment, 0, len(instance.Spec.Roles))
+
+	for _, rl := range instance.Spec.Roles {
+		a := jenkinsApi.RoleAssignment{
+			RoleType: instance.Spec.RoleType,
+			Role:     rl,
+			Group:    instance.Spec.Group,
+		}
+
+		if !containsAssignment(desired, a) {
+			desired = append(desired, a)
+		}
+	}
+
+	return desired
+}
+
+func containsAssignment(assignments []jenkinsApi.RoleAssignment, a jenkinsApi.RoleAssignment) bool {
+	for _, v := range assignments {
+		if v == a {
+			return true
+		}
+	}
+
+	return false
+}
+
+func makeResult(a jenkinsApi.RoleAssignment, action string, err error) jenkinsApi.RoleAssignmentResult {
+	result := jenkinsApi.RoleAssignmentResult{
+		RoleAssignment: a,
+		Action:         action,
+		Status:         helper.StatusSuccess,
+	}
+
+	if err != nil {
+		result.Status = "error"
+		result.Message = err.Error()
+	}
+
+	return result
+}
+
 func (r *Reconcile) updateInstanceStatus(
 	ctx context.Context,
 	instance *jenkinsApi.JenkinsAuthorizationRoleMapping,
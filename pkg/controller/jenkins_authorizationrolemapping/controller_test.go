@@ -7,10 +7,9 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
-	v1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -19,6 +18,7 @@ import (
 	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
 	"github.com/epam/edp-jenkins-operator/v2/pkg/client/jenkins"
 	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/helper"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/helper/testhelper"
 )
 
 func getTestJenkinsAuthorizationRoleMapping() *jenkinsApi.JenkinsAuthorizationRoleMapping {
@@ -27,10 +27,6 @@ func getTestJenkinsAuthorizationRoleMapping() *jenkinsApi.JenkinsAuthorizationRo
 			Name:      "test",
 			Namespace: "nss",
 		},
-		TypeMeta: metav1.TypeMeta{
-			Kind:       "JenkinsAuthorizationRoleMapping",
-			APIVersion: "apps/v1",
-		},
 		Spec: jenkinsApi.JenkinsAuthorizationRoleMappingSpec{
 			RoleType: "rt",
 			Group:    "mke@test.com",
@@ -42,10 +38,7 @@ func getTestJenkinsAuthorizationRoleMapping() *jenkinsApi.JenkinsAuthorizationRo
 func TestReconcile_Reconcile(t *testing.T) {
 	jarm := getTestJenkinsAuthorizationRoleMapping()
 
-	s := scheme.Scheme
-	s.AddKnownTypes(v1.SchemeGroupVersion, jarm)
-
-	k8sClient := fake.NewClientBuilder().WithRuntimeObjects(jarm).Build()
+	k8sClient := testhelper.NewFakeClient(t, jarm)
 	jClient := jenkins.ClientMock{}
 	jBuilder := jenkins.ClientBuilderMock{}
 	jBuilder.On("MakeNewClient", jarm.Spec.OwnerName).Return(&jClient, nil)
@@ -70,10 +63,7 @@ func TestReconcile_Reconcile_Delete(t *testing.T) {
 	jarm := getTestJenkinsAuthorizationRoleMapping()
 	jarm.DeletionTimestamp = &metav1.Time{Time: time.Now()}
 
-	s := scheme.Scheme
-	s.AddKnownTypes(v1.SchemeGroupVersion, jarm)
-
-	k8sClient := fake.NewClientBuilder().WithRuntimeObjects(jarm).Build()
+	k8sClient := testhelper.NewFakeClient(t, jarm)
 	jClient := jenkins.ClientMock{}
 	jBuilder := jenkins.ClientBuilderMock{}
 	jBuilder.On("MakeNewClient", jarm.Spec.OwnerName).Return(&jClient, nil)
@@ -116,10 +106,7 @@ func TestReconcile_Reconcile_Delete_Failure_UnsetRoles(t *testing.T) {
 	jarm := getTestJenkinsAuthorizationRoleMapping()
 	jarm.DeletionTimestamp = &metav1.Time{Time: time.Now()}
 
-	s := scheme.Scheme
-	s.AddKnownTypes(v1.SchemeGroupVersion, jarm)
-
-	k8sClient := fake.NewClientBuilder().WithRuntimeObjects(jarm).Build()
+	k8sClient := testhelper.NewFakeClient(t, jarm)
 	jClient := jenkins.ClientMock{}
 	jBuilder := jenkins.ClientBuilderMock{}
 	jBuilder.On("MakeNewClient", jarm.Spec.OwnerName).Return(&jClient, nil)
@@ -182,10 +169,7 @@ func TestNewReconciler(t *testing.T) {
 
 func TestReconcile_Reconcile_FailureNotFound(t *testing.T) {
 	jarm := getTestJenkinsAuthorizationRoleMapping()
-	s := scheme.Scheme
-	s.AddKnownTypes(v1.SchemeGroupVersion, jarm)
-
-	k8sClient := fake.NewClientBuilder().WithRuntimeObjects(jarm).Build()
+	k8sClient := testhelper.NewFakeClient(t, jarm)
 	logger := helper.LoggerMock{}
 
 	r := Reconcile{
@@ -208,10 +192,7 @@ func TestReconcile_Reconcile_FailureNotFound(t *testing.T) {
 
 func TestReconcile_Reconcile_FailureMakeJenkinsClient(t *testing.T) {
 	jarm := getTestJenkinsAuthorizationRoleMapping()
-	s := scheme.Scheme
-	s.AddKnownTypes(v1.SchemeGroupVersion, jarm)
-
-	k8sClient := fake.NewClientBuilder().WithRuntimeObjects(jarm).Build()
+	k8sClient := testhelper.NewFakeClient(t, jarm)
 	logger := helper.LoggerMock{}
 	jBuilder := jenkins.ClientBuilderMock{}
 	jBuilder.On("MakeNewClient", jarm.Spec.OwnerName).
@@ -239,15 +220,13 @@ func TestReconcile_Reconcile_FailureMakeJenkinsClient(t *testing.T) {
 func TestReconcile_Reconcile_AssignRoleFailure(t *testing.T) {
 	jarm := getTestJenkinsAuthorizationRoleMapping()
 
-	s := scheme.Scheme
-	s.AddKnownTypes(v1.SchemeGroupVersion, jarm)
-
-	k8sClient := fake.NewClientBuilder().WithRuntimeObjects(jarm).Build()
+	k8sClient := testhelper.NewFakeClient(t, jarm)
 	jClient := jenkins.ClientMock{}
 	jBuilder := jenkins.ClientBuilderMock{}
 	jBuilder.On("MakeNewClient", jarm.Spec.OwnerName).Return(&jClient, nil)
 	jClient.On("AssignRole", jarm.Spec.RoleType, jarm.Spec.Roles[0], jarm.Spec.Group).
 		Return(errors.New("assign fatal"))
+	jClient.On("AssignRole", jarm.Spec.RoleType, jarm.Spec.Roles[1], jarm.Spec.Group).Return(nil)
 
 	logger := helper.LoggerMock{}
 
@@ -267,5 +246,103 @@ func TestReconcile_Reconcile_AssignRoleFailure(t *testing.T) {
 	lastErr := logger.LastError()
 	require.Error(t, lastErr)
 
-	require.Contains(t, lastErr.Error(), "assign fatal")
+	require.Contains(t, lastErr.Error(), "failed to sync 1 of 2 role assignments")
+
+	checkInstance := getInstance(t, k8sClient, jarm)
+	require.Equal(t, []jenkinsApi.RoleAssignment{
+		{RoleType: jarm.Spec.RoleType, Role: jarm.Spec.Roles[1], Group: jarm.Spec.Group},
+	}, checkInstance.Status.Assigned)
+	require.Len(t, checkInstance.Status.Roles, 2)
+	require.Equal(t, "error", checkInstance.Status.Roles[0].Status)
+	require.Contains(t, checkInstance.Status.Roles[0].Message, "assign fatal")
+	require.Equal(t, helper.StatusSuccess, checkInstance.Status.Roles[1].Status)
+}
+
+func TestReconcile_Reconcile_ChangedRoles(t *testing.T) {
+	jarm := getTestJenkinsAuthorizationRoleMapping()
+	jarm.Status.Assigned = []jenkinsApi.RoleAssignment{
+		{RoleType: jarm.Spec.RoleType, Role: jarm.Spec.Roles[0], Group: jarm.Spec.Group},
+		{RoleType: jarm.Spec.RoleType, Role: "removed", Group: jarm.Spec.Group},
+		{RoleType: jarm.Spec.RoleType, Role: jarm.Spec.Roles[1], Group: "old-group"},
+	}
+
+	k8sClient := testhelper.NewFakeClient(t, jarm)
+	jClient := jenkins.ClientMock{}
+	jBuilder := jenkins.ClientBuilderMock{}
+	jBuilder.On("MakeNewClient", jarm.Spec.OwnerName).Return(&jClient, nil)
+	jClient.On("UnAssignRole", jarm.Spec.RoleType, "removed", jarm.Spec.Group).Return(nil)
+	jClient.On("UnAssignRole", jarm.Spec.RoleType, jarm.Spec.Roles[1], "old-group").Return(nil)
+	jClient.On("AssignRole", jarm.Spec.RoleType, jarm.Spec.Roles[1], jarm.Spec.Group).Return(nil)
+
+	r := Reconcile{
+		client:               k8sClient,
+		jenkinsClientFactory: &jBuilder,
+		log:                  &helper.LoggerMock{},
+	}
+
+	req := reconcile.Request{
+		NamespacedName: types.NamespacedName{Namespace: jarm.Namespace, Name: jarm.Name},
+	}
+
+	_, err := r.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+	jClient.AssertExpectations(t)
+	jClient.AssertNotCalled(t, "AssignRole", jarm.Spec.RoleType, jarm.Spec.Roles[0], jarm.Spec.Group)
+
+	checkInstance := getInstance(t, k8sClient, jarm)
+	require.Equal(t, helper.StatusSuccess, checkInstance.Status.Value)
+	require.Equal(t, []jenkinsApi.RoleAssignment{
+		{RoleType: jarm.Spec.RoleType, Role: jarm.Spec.Roles[0], Group: jarm.Spec.Group},
+		{RoleType: jarm.Spec.RoleType, Role: jarm.Spec.Roles[1], Group: jarm.Spec.Group},
+	}, checkInstance.Status.Assigned)
+	require.Len(t, checkInstance.Status.Roles, 3)
+}
+
+func TestReconcile_Reconcile_RolesChangedAfterCreation(t *testing.T) {
+	jarm := getTestJenkinsAuthorizationRoleMapping()
+	k8sClient := testhelper.NewFakeClient(t, jarm)
+	jClient := jenkins.ClientMock{}
+	jClient.On("AssignRole", jarm.Spec.RoleType, jarm.Spec.Roles[0], jarm.Spec.Group).Return(nil)
+	jClient.On("AssignRole", jarm.Spec.RoleType, jarm.Spec.Roles[1], jarm.Spec.Group).Return(nil)
+
+	r := Reconcile{
+		client:               k8sClient,
+		jenkinsClientFactory: testhelper.NewClientFactory(&jClient),
+		log:                  &helper.LoggerMock{},
+	}
+
+	testhelper.Reconcile(t, &r, jarm.Namespace, jarm.Name)
+
+	// the assignments synced on the first reconciliation are kept across the finalizer update.
+	checkInstance := getInstance(t, k8sClient, jarm)
+	require.Equal(t, []string{finalizerName}, checkInstance.GetFinalizers())
+	require.Len(t, checkInstance.Status.Assigned, 2)
+
+	checkInstance.Spec.Roles = jarm.Spec.Roles[:1]
+	require.NoError(t, k8sClient.Update(context.Background(), checkInstance))
+
+	jClient.On("UnAssignRole", jarm.Spec.RoleType, jarm.Spec.Roles[1], jarm.Spec.Group).Return(nil)
+
+	testhelper.Reconcile(t, &r, jarm.Namespace, jarm.Name)
+	jClient.AssertExpectations(t)
+
+	checkInstance = getInstance(t, k8sClient, jarm)
+	require.Equal(t, []jenkinsApi.RoleAssignment{
+		{RoleType: jarm.Spec.RoleType, Role: jarm.Spec.Roles[0], Group: jarm.Spec.Group},
+	}, checkInstance.Status.Assigned)
+}
+
+func getInstance(
+	t *testing.T,
+	k8sClient client.Client,
+	jarm *jenkinsApi.JenkinsAuthorizationRoleMapping,
+) *jenkinsApi.JenkinsAuthorizationRoleMapping {
+	t.Helper()
+
+	var checkInstance jenkinsApi.JenkinsAuthorizationRoleMapping
+
+	require.NoError(t, k8sClient.Get(context.Background(),
+		types.NamespacedName{Namespace: jarm.Namespace, Name: jarm.Name}, &checkInstance))
+
+	return &checkInstance
 }
@@ -0,0 +1,250 @@
+package jenkins_configurationascode
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/yaml"
+
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/client/jenkins"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/helper"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/service/platform"
+)
+
+// syncInterval is how often the ConfigMaps are read again to apply the changed fragments.
+const syncInterval = 2 * time.Minute
+
+type Reconcile struct {
+	client               client.Client
+	log                  logr.Logger
+	jenkinsClientFactory jenkins.ClientFactory
+	now                  func() time.Time
+}
+
+func NewReconciler(k8sCl client.Client, logf logr.Logger, ps platform.PlatformService) *Reconcile {
+	return &Reconcile{
+		client:               k8sCl,
+		log:                  logf.WithName("controller_jenkins_configuration_as_code"),
+		jenkinsClientFactory: jenkins.MakeClientBuilder(ps, k8sCl),
+		now:                  time.Now,
+	}
+}
+
+func (r *Reconcile) SetupWithManager(mgr ctrl.Manager) error {
+	p := predicate.Funcs{
+		UpdateFunc: specUpdated,
+	}
+
+	if err := ctrl.NewControllerManagedBy(mgr).
+		For(&jenkinsApi.JenkinsConfigurationAsCode{}, builder.WithPredicates(p)).
+		Complete(r); err != nil {
+		return fmt.Errorf("failed to create new managed controller: %w", err)
+	}
+
+	return nil
+}
+
+func specUpdated(e event.UpdateEvent) bool {
+	oldObject, ok := e.ObjectOld.(*jenkinsApi.JenkinsConfigurationAsCode)
+	if !ok {
+		return false
+	}
+
+	newObject, ok := e.ObjectNew.(*jenkinsApi.JenkinsConfigurationAsCode)
+	if !ok {
+		return false
+	}
+
+	return !reflect.DeepEqual(oldObject.Spec, newObject.Spec)
+}
+
+func (r *Reconcile) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	reqLogger := r.log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+	reqLogger.Info("Reconciling JenkinsConfigurationAsCode has been started")
+
+	instance := new(jenkinsApi.JenkinsConfigurationAsCode)
+
+	if err := r.client.Get(ctx, request.NamespacedName, instance); err != nil {
+		if k8serrors.IsNotFound(err) {
+			reqLogger.Info("instance not found")
+
+			return reconcile.Result{}, nil
+		}
+
+		return reconcile.Result{}, fmt.Errorf("failed to get JenkinsConfigurationAsCode instance: %w", err)
+	}
+
+	// the applied configuration stays in Jenkins when the instance is deleted, so there is nothing to clean up.
+	if !instance.GetDeletionTimestamp().IsZero() {
+		return reconcile.Result{}, nil
+	}
+
+	if err := r.tryToReconcile(ctx, instance); err != nil {
+		r.log.Error(err, "error during reconciliation", "instance", instance)
+		r.updateInstanceStatus(ctx, instance, err.Error())
+
+		return reconcile.Result{RequeueAfter: helper.DefaultRequeueTime * time.Second}, nil
+	}
+
+	r.updateInstanceStatus(ctx, instance, helper.StatusSuccess)
+
+	reqLogger.Info("Reconciling JenkinsConfigurationAsCode has been finished")
+
+	return reconcile.Result{RequeueAfter: syncInterval}, nil
+}
+
+// tryToReconcile validates and applies the assembled configuration when its revision differs from the applied one.
+func (r *Reconcile) tryToReconcile(ctx context.Context, instance *jenkinsApi.JenkinsConfigurationAsCode) error {
+	config, err := r.assembleConfiguration(ctx, instance)
+	if err != nil {
+		return err
+	}
+
+	revision := configurationRevision(config)
+	if revision == instance.Status.Revision && instance.Status.Value == helper.StatusSuccess {
+		return nil
+	}
+
+	jc, err := r.jenkinsClientFactory.MakeNewClient(&instance.ObjectMeta, instance.Spec.OwnerName)
+	if err != nil {
+		return fmt.Errorf("failed to create gojenkins client: %w", err)
+	}
+
+	warnings, err := jc.CheckConfigurationAsCode(config)
+	if err != nil {
+		return fmt.Errorf("failed to validate revision %s: %w", revision, err)
+	}
+
+	if err = jc.ApplyConfigurationAsCode(config); err != nil {
+		return fmt.Errorf("failed to apply revision %s: %w", revision, err)
+	}
+
+	instance.Status.Revision = revision
+	instance.Status.LastAppliedTime = &metav1.Time{Time: r.now()}
+	instance.Status.Warnings = warnings
+
+	r.log.Info("configuration as code has been applied", "instance", instance.Name, "revision", revision)
+
+	return nil
+}
+
+// assembleConfiguration merges the YAML fragments of the sources into a single configuration.
+func (r *Reconcile) assembleConfiguration(ctx context.Context, instance *jenkinsApi.JenkinsConfigurationAsCode) (string, error) {
+	config := make(map[string]interface{})
+
+	for _, source := range instance.Spec.Sources {
+		cm := &corev1.ConfigMap{}
+
+		if err := r.client.Get(ctx, types.NamespacedName{Namespace: instance.Namespace, Name: source.ConfigMapName}, cm); err != nil {
+			return "", fmt.Errorf("failed to get config map %s: %w", source.ConfigMapName, err)
+		}
+
+		keys := source.Keys
+		if len(keys) == 0 {
+			keys = make([]string, 0, len(cm.Data))
+			for k := range cm.Data {
+				keys = append(keys, k)
+			}
+
+			sort.Strings(keys)
+		}
+
+		for _, key := range keys {
+			data, ok := cm.Data[key]
+			if !ok {
+				return "", fmt.Errorf("config map %s has no key %s", source.ConfigMapName, key)
+			}
+
+			fragment := make(map[string]interface{})
+
+			if err := yaml.Unmarshal([]byte(data), &fragment); err != nil {
+				return "", fmt.Errorf("failed to parse %s/%s: %w", source.ConfigMapName, key, err)
+			}
+
+			if err := mergeFragment(config, fragment, ""); err != nil {
+				return "", fmt.Errorf("failed to merge %s/%s: %w", source.ConfigMapName, key, err)
+			}
+		}
+	}
+
+	out, err := yaml.Marshal(config)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal configuration: %w", err)
+	}
+
+	return string(out), nil
+}
+
+// mergeFragment merges src into dst the way Configuration as Code merges several files:
+// mappings are merged, sequences are concatenated and different scalar values are a conflict.
+func mergeFragment(dst, src map[string]interface{}, path string) error {
+	for k, v := range src {
+		p := k
+		if path != "" {
+			p = path + "." + k
+		}
+
+		current, ok := dst[k]
+		if !ok {
+			dst[k] = v
+
+			continue
+		}
+
+		switch cv := current.(type) {
+		case map[string]interface{}:
+			sv, ok := v.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("conflicting types at %s", p)
+			}
+
+			if err := mergeFragment(cv, sv, p); err != nil {
+				return err
+			}
+		case []interface{}:
+			sv, ok := v.([]interface{})
+			if !ok {
+				return fmt.Errorf("conflicting types at %s", p)
+			}
+
+			dst[k] = append(cv, sv...)
+		default:
+			if !reflect.DeepEqual(current, v) {
+				return fmt.Errorf("conflicting values at %s", p)
+			}
+		}
+	}
+
+	return nil
+}
+
+func configurationRevision(config string) string {
+	sum := sha256.Sum256([]byte(config))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func (r *Reconcile) updateInstanceStatus(ctx context.Context, instance *jenkinsApi.JenkinsConfigurationAsCode, statusValue string) {
+	instance.Status.Value = statusValue
+
+	if err := r.client.Status().Update(ctx, instance); err != nil {
+		r.log.Error(err, "unable to update status", "instance", instance)
+	}
+}
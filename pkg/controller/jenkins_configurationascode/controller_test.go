@@ -0,0 +1,177 @@
+package jenkins_configurationascode
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/client/jenkins"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/helper"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/helper/testhelper"
+)
+
+const (
+	name      = "casc"
+	namespace = "ns"
+
+	assembledConfig = `jenkins:
+  globalNodeProperties:
+  - envVars:
+      env:
+      - key: A
+        value: a
+  - envVars:
+      env:
+      - key: B
+        value: b
+  systemMessage: managed
+unclassified:
+  location:
+    url: https://jenkins.example.com/
+`
+)
+
+func getTestInstance() *jenkinsApi.JenkinsConfigurationAsCode {
+	return &jenkinsApi.JenkinsConfigurationAsCode{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: jenkinsApi.JenkinsConfigurationAsCodeSpec{
+			Sources: []jenkinsApi.ConfigurationAsCodeSource{
+				{ConfigMapName: "base"},
+				{ConfigMapName: "extra", Keys: []string{"env.yaml"}},
+			},
+		},
+	}
+}
+
+func newTestReconcile(t *testing.T, jClient *jenkins.ClientMock, objects ...client.Object) (*Reconcile, client.Client) {
+	t.Helper()
+
+	base := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "base", Namespace: namespace},
+		Data: map[string]string{
+			"b-location.yaml": "unclassified:\n  location:\n    url: https://jenkins.example.com/\n",
+			"a-jenkins.yaml": "jenkins:\n  systemMessage: managed\n  globalNodeProperties:\n" +
+				"    - envVars:\n        env:\n          - key: A\n            value: a\n",
+		},
+	}
+	extra := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "extra", Namespace: namespace},
+		Data: map[string]string{
+			"env.yaml":    "jenkins:\n  globalNodeProperties:\n    - envVars:\n        env:\n          - key: B\n            value: b\n",
+			"unused.yaml": "jenkins:\n  systemMessage: other\n",
+		},
+	}
+
+	k8sClient := testhelper.NewFakeClient(t, append(objects, base, extra)...)
+
+	return &Reconcile{
+		client:               k8sClient,
+		jenkinsClientFactory: testhelper.NewClientFactory(jClient),
+		log:                  &helper.LoggerMock{},
+		now:                  time.Now,
+	}, k8sClient
+}
+
+func TestReconcile_Reconcile(t *testing.T) {
+	jClient := jenkins.ClientMock{}
+	jClient.On("CheckConfigurationAsCode", assembledConfig).Return([]string{"line 1: deprecated"}, nil)
+	jClient.On("ApplyConfigurationAsCode", assembledConfig).Return(nil)
+
+	r, k8sClient := newTestReconcile(t, &jClient, getTestInstance())
+
+	res := testhelper.Reconcile(t, r, namespace, name)
+	require.Equal(t, syncInterval, res.RequeueAfter)
+	jClient.AssertExpectations(t)
+
+	instance := testhelper.Get[jenkinsApi.JenkinsConfigurationAsCode](t, k8sClient, namespace, name)
+	require.Equal(t, helper.StatusSuccess, instance.Status.Value)
+	require.Equal(t, configurationRevision(assembledConfig), instance.Status.Revision)
+	require.NotNil(t, instance.Status.LastAppliedTime)
+	require.Equal(t, []string{"line 1: deprecated"}, instance.Status.Warnings)
+
+	// the unchanged revision is not applied again.
+	testhelper.Reconcile(t, r, namespace, name)
+	jClient.AssertNumberOfCalls(t, "ApplyConfigurationAsCode", 1)
+}
+
+func TestReconcile_Reconcile_InvalidConfiguration(t *testing.T) {
+	instance := getTestInstance()
+	instance.Status.Revision = "applied"
+
+	jClient := jenkins.ClientMock{}
+	jClient.On("CheckConfigurationAsCode", assembledConfig).Return(nil, errors.New("invalid"))
+
+	r, k8sClient := newTestReconcile(t, &jClient, instance)
+
+	res := testhelper.Reconcile(t, r, namespace, name)
+	require.Equal(t, helper.DefaultRequeueTime*time.Second, res.RequeueAfter)
+	jClient.AssertNotCalled(t, "ApplyConfigurationAsCode", assembledConfig)
+
+	checkInstance := testhelper.Get[jenkinsApi.JenkinsConfigurationAsCode](t, k8sClient, namespace, name)
+	require.Contains(t, checkInstance.Status.Value, "failed to validate revision")
+	require.Equal(t, "applied", checkInstance.Status.Revision)
+}
+
+func TestReconcile_Reconcile_MissingKey(t *testing.T) {
+	instance := getTestInstance()
+	instance.Spec.Sources[1].Keys = []string{"missing.yaml"}
+
+	r, k8sClient := newTestReconcile(t, &jenkins.ClientMock{}, instance)
+
+	testhelper.Reconcile(t, r, namespace, name)
+	require.Equal(t, "config map extra has no key missing.yaml", testhelper.Get[jenkinsApi.JenkinsConfigurationAsCode](t, k8sClient, namespace, name).Status.Value)
+}
+
+func TestMergeFragment(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		src     map[string]interface{}
+		wantErr string
+	}{
+		{
+			name: "should merge mappings",
+			src:  map[string]interface{}{"jenkins": map[string]interface{}{"numExecutors": float64(0)}},
+		},
+		{
+			name: "should accept equal values",
+			src:  map[string]interface{}{"jenkins": map[string]interface{}{"systemMessage": "managed"}},
+		},
+		{
+			name:    "should reject conflicting values",
+			src:     map[string]interface{}{"jenkins": map[string]interface{}{"systemMessage": "other"}},
+			wantErr: "conflicting values at jenkins.systemMessage",
+		},
+		{
+			name:    "should reject conflicting types",
+			src:     map[string]interface{}{"jenkins": "value"},
+			wantErr: "conflicting types at jenkins",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			dst := map[string]interface{}{"jenkins": map[string]interface{}{"systemMessage": "managed"}}
+
+			err := mergeFragment(dst, tt.src, "")
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -30,6 +30,7 @@ func CreateCDPipelineFolderChain(s *runtime.Scheme, c client.Client) (handler.Je
 		client: c,
 		ps:     ps,
 		scheme: s,
+		next:   newSetFolderPermissions(c, ps),
 	}, nil
 }
 
@@ -47,6 +48,7 @@ func CreateTriggerBuildProvisionChain(s *runtime.Scheme, c client.Client) (handl
 	return TriggerBuildJobProvision{
 		client: c,
 		ps:     ps,
+		next:   newSetFolderPermissions(c, ps),
 	}, nil
 }
 
@@ -0,0 +1,126 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+	jenkinsClient "github.com/epam/edp-jenkins-operator/v2/pkg/client/jenkins"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/helper"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/jenkins_folder/chain/handler"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/service/platform"
+)
+
+// FolderRoleType is the type of the roles granting permissions on folders.
+const FolderRoleType = "projectRoles"
+
+// SetFolderPermissions grants spec.permissions on the folder with project roles scoped to the folder
+// and removes the roles of the grants which are no longer in the spec.
+type SetFolderPermissions struct {
+	next                 handler.JenkinsFolderHandler
+	client               client.Client
+	jenkinsClientFactory jenkinsClient.ClientFactory
+}
+
+func newSetFolderPermissions(c client.Client, ps platform.PlatformService) SetFolderPermissions {
+	return SetFolderPermissions{
+		client:               c,
+		jenkinsClientFactory: jenkinsClient.MakeClientBuilder(ps, c),
+	}
+}
+
+func (h SetFolderPermissions) ServeRequest(jf *jenkinsApi.JenkinsFolder) error {
+	if len(jf.Spec.Permissions) == 0 && len(jf.Status.PermissionRoles) == 0 {
+		return nextServeOrNil(h.next, jf)
+	}
+
+	log.V(2).Info("start setting folder permissions", "name", jf.Name)
+
+	jc, err := h.jenkinsClientFactory.MakeNewClient(&jf.ObjectMeta, jf.Spec.OwnerName)
+	if err != nil {
+		return fmt.Errorf("failed to create jenkins client: %w", err)
+	}
+
+	folder := jf.GetFolderName()
+	pattern := folderPattern(folder)
+	permissions := mergePermissions(jf.Spec.Permissions)
+	roles := make([]string, 0, len(permissions))
+
+	for _, p := range permissions {
+		role := folderRoleName(folder, p.Subject)
+
+		// overwriting keeps the role in sync with the spec, the assignment is restored right after.
+		if err = jc.UpdateRole(FolderRoleType, role, pattern, p.Permissions); err != nil {
+			return fmt.Errorf("failed to set role %s: %w", role, err)
+		}
+
+		if err = jc.AssignRole(FolderRoleType, role, p.Subject); err != nil {
+			return fmt.Errorf("failed to assign role %s to %s: %w", role, p.Subject, err)
+		}
+
+		roles = append(roles, role)
+	}
+
+	if stale := staleRoles(jf.Status.PermissionRoles, roles); len(stale) > 0 {
+		if err = jc.RemoveRoles(FolderRoleType, stale); err != nil {
+			return fmt.Errorf("failed to remove stale folder roles: %w", err)
+		}
+	}
+
+	jf.Status.PermissionRoles = roles
+
+	if err = h.client.Status().Update(context.TODO(), jf); err != nil {
+		return fmt.Errorf("failed to update JenkinsFolder status: %w", err)
+	}
+
+	log.Info("folder permissions have been set", "name", jf.Name)
+
+	return nextServeOrNil(h.next, jf)
+}
+
+// mergePermissions merges the permissions of the entries with the same subject, since a subject has a single folder role.
+func mergePermissions(permissions []jenkinsApi.FolderPermission) []jenkinsApi.FolderPermission {
+	merged := make([]jenkinsApi.FolderPermission, 0, len(permissions))
+	index := make(map[string]int, len(permissions))
+
+	for _, p := range permissions {
+		i, ok := index[p.Subject]
+		if !ok {
+			index[p.Subject] = len(merged)
+			merged = append(merged, jenkinsApi.FolderPermission{Subject: p.Subject})
+			i = len(merged) - 1
+		}
+
+		for _, id := range p.Permissions {
+			if !helper.ContainsString(merged[i].Permissions, id) {
+				merged[i].Permissions = append(merged[i].Permissions, id)
+			}
+		}
+	}
+
+	return merged
+}
+
+func folderRoleName(folder, subject string) string {
+	return fmt.Sprintf("folder-%s-%s", folder, subject)
+}
+
+// folderPattern matches the folder and all its items.
+func folderPattern(folder string) string {
+	return fmt.Sprintf("^%s(/.*)?$", regexp.QuoteMeta(folder))
+}
+
+func staleRoles(applied, desired []string) []string {
+	var stale []string
+
+	for _, a := range applied {
+		if !helper.ContainsString(desired, a) {
+			stale = append(stale, a)
+		}
+	}
+
+	return stale
+}
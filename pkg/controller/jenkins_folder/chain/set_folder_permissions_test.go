@@ -0,0 +1,74 @@
+package chain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/client/jenkins"
+)
+
+func TestSetFolderPermissions_ServeRequest(t *testing.T) {
+	jf := &jenkinsApi.JenkinsFolder{
+		ObjectMeta: ObjectMeta(),
+		Spec: jenkinsApi.JenkinsFolderSpec{
+			Permissions: []jenkinsApi.FolderPermission{
+				{Subject: "team-a", Permissions: []string{"hudson.model.Item.Build"}},
+			},
+		},
+		Status: jenkinsApi.JenkinsFolderStatus{
+			PermissionRoles: []string{"folder-name-team-a", "folder-name-team-b"},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, jenkinsApi.AddToScheme(scheme))
+
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(jf).Build()
+
+	jc := jenkins.ClientMock{}
+	jc.On("UpdateRole", FolderRoleType, "folder-name-team-a", "^name(/.*)?$", []string{"hudson.model.Item.Build"}).
+		Return(nil)
+	jc.On("AssignRole", FolderRoleType, "folder-name-team-a", "team-a").Return(nil)
+	jc.On("RemoveRoles", FolderRoleType, []string{"folder-name-team-b"}).Return(nil)
+
+	factory := jenkins.ClientBuilderMock{}
+	factory.On("MakeNewClient", jf.Spec.OwnerName).Return(&jc, nil)
+
+	h := SetFolderPermissions{
+		client:               k8sClient,
+		jenkinsClientFactory: &factory,
+	}
+
+	require.NoError(t, h.ServeRequest(jf))
+	jc.AssertExpectations(t)
+
+	var updated jenkinsApi.JenkinsFolder
+	require.NoError(t, k8sClient.Get(context.Background(), nsn(), &updated))
+	require.Equal(t, []string{"folder-name-team-a"}, updated.Status.PermissionRoles)
+}
+
+func TestSetFolderPermissions_ServeRequest_NoPermissions(t *testing.T) {
+	h := SetFolderPermissions{}
+
+	require.NoError(t, h.ServeRequest(&jenkinsApi.JenkinsFolder{ObjectMeta: ObjectMeta()}))
+}
+
+func TestMergePermissions(t *testing.T) {
+	require.Equal(t, []jenkinsApi.FolderPermission{
+		{Subject: "team-a", Permissions: []string{"hudson.model.Item.Build", "hudson.model.Item.Read", "hudson.model.Item.Cancel"}},
+		{Subject: "team-b", Permissions: []string{"hudson.model.Item.Read"}},
+	}, mergePermissions([]jenkinsApi.FolderPermission{
+		{Subject: "team-a", Permissions: []string{"hudson.model.Item.Build", "hudson.model.Item.Read"}},
+		{Subject: "team-b", Permissions: []string{"hudson.model.Item.Read"}},
+		{Subject: "team-a", Permissions: []string{"hudson.model.Item.Read", "hudson.model.Item.Cancel"}},
+	}))
+}
+
+func TestFolderPattern(t *testing.T) {
+	require.Equal(t, `^team\.a(/.*)?$`, folderPattern("team.a"))
+}
@@ -43,11 +43,9 @@ func (h TriggerBuildJobProvision) ServeRequest(jf *jenkinsApi.JenkinsFolder) err
 }
 
 func (h TriggerBuildJobProvision) setStatus(jf *jenkinsApi.JenkinsFolder, status string) error {
-	jf.Status = jenkinsApi.JenkinsFolderStatus{
-		Available:       true,
-		LastTimeUpdated: metav1.NewTime(time.Now()),
-		Status:          status,
-	}
+	jf.Status.Available = true
+	jf.Status.LastTimeUpdated = metav1.NewTime(time.Now())
+	jf.Status.Status = status
 
 	return h.updateStatus(jf)
 }
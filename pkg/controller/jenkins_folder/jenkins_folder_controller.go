@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"reflect"
-	"strings"
 
 	"github.com/go-logr/logr"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -177,6 +176,12 @@ func (r *ReconcileJenkinsFolder) tryToDeleteJenkinsFolder(
 
 	jenkinsFolderName := r.getJenkinsFolderName(jenkinsFolder)
 
+	if len(jenkinsFolder.Status.PermissionRoles) > 0 {
+		if err := jc.RemoveRoles(chain.FolderRoleType, jenkinsFolder.Status.PermissionRoles); err != nil {
+			return &reconcile.Result{}, fmt.Errorf("failed to remove folder permissions: %w", err)
+		}
+	}
+
 	if _, err := jc.GoJenkins.DeleteJob(jenkinsFolderName); err != nil {
 		if helper.JenkinsIsNotFoundErr(err) {
 			return &reconcile.Result{}, fmt.Errorf("failed to delete JenkinsFolder: %w", err)
@@ -195,9 +200,5 @@ func (r *ReconcileJenkinsFolder) tryToDeleteJenkinsFolder(
 }
 
 func (*ReconcileJenkinsFolder) getJenkinsFolderName(jf *jenkinsApi.JenkinsFolder) string {
-	if jf.Spec.Job == nil {
-		return jf.Name
-	}
-
-	return strings.ReplaceAll(jf.Name, "-codebase", "")
+	return jf.GetFolderName()
 }
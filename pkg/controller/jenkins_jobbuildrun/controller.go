@@ -4,18 +4,22 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/bndr/gojenkins"
 	"github.com/go-logr/logr"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
 	"github.com/epam/edp-jenkins-operator/v2/pkg/client/jenkins"
@@ -25,12 +29,17 @@ import (
 
 const (
 	retryInterval = 10 * time.Second
+	// fallbackRetryInterval is used instead of retryInterval when build events are pushed by Jenkins.
+	fallbackRetryInterval = 2 * time.Minute
+	// missingUpstreamTimeout is how long a run waits for its upstream runs to be created.
+	missingUpstreamTimeout = 5 * time.Minute
 )
 
 type Reconcile struct {
 	client               client.Client
 	log                  logr.Logger
 	jenkinsClientFactory jenkins.ClientFactory
+	pollInterval         time.Duration
 }
 
 func NewReconciler(k8sCl client.Client, logf logr.Logger, ps platform.PlatformService) *Reconcile {
@@ -38,18 +47,26 @@ func NewReconciler(k8sCl client.Client, logf logr.Logger, ps platform.PlatformSe
 		client:               k8sCl,
 		log:                  logf.WithName("controller_jenkins_jobbuildrun"),
 		jenkinsClientFactory: jenkins.MakeClientBuilder(ps, k8sCl),
+		pollInterval:         retryInterval,
 	}
 }
 
-func (r *Reconcile) SetupWithManager(mgr ctrl.Manager) error {
+// SetupWithManager registers the controller. If buildEvents is not nil, JenkinsJobBuildRuns received
+// from the channel are reconciled immediately and Jenkins is polled with fallbackRetryInterval.
+func (r *Reconcile) SetupWithManager(mgr ctrl.Manager, buildEvents <-chan event.GenericEvent) error {
 	p := predicate.Funcs{
 		UpdateFunc: specUpdated,
 	}
 
-	err := ctrl.NewControllerManagedBy(mgr).
-		For(&jenkinsApi.JenkinsJobBuildRun{}, builder.WithPredicates(p)).
-		Complete(r)
-	if err != nil {
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&jenkinsApi.JenkinsJobBuildRun{}, builder.WithPredicates(p))
+
+	if buildEvents != nil {
+		r.pollInterval = fallbackRetryInterval
+		b = b.Watches(&source.Channel{Source: buildEvents}, &handler.EnqueueRequestForObject{})
+	}
+
+	if err := b.Complete(r); err != nil {
 		return fmt.Errorf("failed to create new managed controller: %w", err)
 	}
 
@@ -98,13 +115,41 @@ func (r *Reconcile) Reconcile(ctx context.Context, request reconcile.Request) (r
 		return result, nil
 	}
 
+	if instance.Status.Status == jenkinsApi.JobBuildRunStatusUpstreamFailed ||
+		instance.Status.Status == jenkinsApi.JobBuildRunStatusInvalidDependencies {
+		reqLogger.V(2).Info("Reconciling JenkinsJobBuildRun has been finished, upstream runs cannot be completed")
+
+		return result, nil
+	}
+
+	if instance.Status.Launches == 0 && len(instance.Spec.DependsOn) > 0 {
+		ready, err := r.checkUpstreamRuns(ctx, &instance)
+		if err != nil {
+			return result, fmt.Errorf("failed to check upstream runs: %w", err)
+		}
+
+		if !ready {
+			instance.Status.LastUpdated = metav1.NewTime(time.Now())
+
+			if err := r.client.Status().Update(ctx, &instance); err != nil {
+				r.log.Error(err, "unable to update status", "instance", instance)
+			}
+
+			if instance.Status.Status == jenkinsApi.JobBuildRunStatusWaiting {
+				result.RequeueAfter = retryInterval
+			}
+
+			return result, nil
+		}
+	}
+
 	jc, err := r.jenkinsClientFactory.MakeNewClient(&instance.ObjectMeta, instance.Spec.OwnerName)
 	if err != nil {
 		return result,
 			fmt.Errorf("failed to create gojenkins client: %w", err)
 	}
 
-	requeue, err := tryToReconcile(&instance, jc)
+	requeue, err := tryToReconcile(&instance, jc, r.getPollInterval())
 	if err != nil {
 		r.log.Error(err, "error during reconciliation", "instance", instance)
 
@@ -125,7 +170,145 @@ func (r *Reconcile) Reconcile(ctx context.Context, request reconcile.Request) (r
 	return result, nil
 }
 
-func tryToReconcile(instance *jenkinsApi.JenkinsJobBuildRun, jc jenkins.ClientInterface) (time.Duration, error) {
+// checkUpstreamRuns checks the state of the upstream runs and stores build numbers of the completed ones.
+// It returns true if all upstream runs are completed.
+func (r *Reconcile) checkUpstreamRuns(ctx context.Context, instance *jenkinsApi.JenkinsJobBuildRun) (bool, error) {
+	var waitFor []string
+
+	for _, dep := range instance.Spec.DependsOn {
+		if dep.Name == instance.Name {
+			setInvalidDependencies(instance, fmt.Sprintf("run %s depends on itself", instance.Name))
+
+			return false, nil
+		}
+
+		if _, ok := instance.Status.UpstreamBuilds[dep.Name]; ok {
+			continue
+		}
+
+		var upstream jenkinsApi.JenkinsJobBuildRun
+
+		err := r.client.Get(ctx, types.NamespacedName{Namespace: instance.Namespace, Name: dep.Name}, &upstream)
+		if err != nil {
+			if k8serrors.IsNotFound(err) {
+				if time.Since(instance.CreationTimestamp.Time) > missingUpstreamTimeout {
+					setInvalidDependencies(instance, fmt.Sprintf("upstream run %s does not exist", dep.Name))
+
+					return false, nil
+				}
+
+				waitFor = append(waitFor, dep.Name)
+
+				continue
+			}
+
+			return false, fmt.Errorf("failed to get upstream JenkinsJobBuildRun %s: %w", dep.Name, err)
+		}
+
+		switch upstream.Status.Status {
+		case jenkinsApi.JobBuildRunStatusCompleted:
+			if instance.Status.UpstreamBuilds == nil {
+				instance.Status.UpstreamBuilds = make(map[string]int64, len(instance.Spec.DependsOn))
+			}
+
+			instance.Status.UpstreamBuilds[dep.Name] = upstream.Status.BuildNumber
+		case jenkinsApi.JobBuildRunStatusFailed,
+			jenkinsApi.JobBuildRunStatusNotFound,
+			jenkinsApi.JobBuildRunStatusUpstreamFailed,
+			jenkinsApi.JobBuildRunStatusInvalidDependencies:
+			instance.Status.Status = jenkinsApi.JobBuildRunStatusUpstreamFailed
+			instance.Status.Message = fmt.Sprintf("upstream run %s has finished with status %s", dep.Name, upstream.Status.Status)
+
+			return false, nil
+		default:
+			waitFor = append(waitFor, dep.Name)
+		}
+	}
+
+	if len(waitFor) > 0 {
+		cycle, err := r.findDependencyCycle(ctx, instance.Namespace, instance.Name, instance.Spec.DependsOn, map[string]bool{})
+		if err != nil {
+			return false, err
+		}
+
+		if len(cycle) > 0 {
+			setInvalidDependencies(instance, fmt.Sprintf("dependency cycle: %s -> %s",
+				instance.Name, strings.Join(cycle, " -> ")))
+
+			return false, nil
+		}
+
+		instance.Status.Status = jenkinsApi.JobBuildRunStatusWaiting
+		instance.Status.Message = fmt.Sprintf("waiting for upstream runs: %s", strings.Join(waitFor, ", "))
+
+		return false, nil
+	}
+
+	instance.Status.Message = ""
+
+	return true, nil
+}
+
+// findDependencyCycle walks the upstream runs of deps and returns the path to the target run if it is reachable.
+func (r *Reconcile) findDependencyCycle(
+	ctx context.Context,
+	namespace, target string,
+	deps []jenkinsApi.JenkinsJobBuildRunDependency,
+	visited map[string]bool,
+) ([]string, error) {
+	for _, dep := range deps {
+		if dep.Name == target {
+			return []string{dep.Name}, nil
+		}
+
+		if visited[dep.Name] {
+			continue
+		}
+
+		visited[dep.Name] = true
+
+		var upstream jenkinsApi.JenkinsJobBuildRun
+
+		err := r.client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: dep.Name}, &upstream)
+		if err != nil {
+			if k8serrors.IsNotFound(err) {
+				continue
+			}
+
+			return nil, fmt.Errorf("failed to get upstream JenkinsJobBuildRun %s: %w", dep.Name, err)
+		}
+
+		path, err := r.findDependencyCycle(ctx, namespace, target, upstream.Spec.DependsOn, visited)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(path) > 0 {
+			return append([]string{dep.Name}, path...), nil
+		}
+	}
+
+	return nil, nil
+}
+
+func setInvalidDependencies(instance *jenkinsApi.JenkinsJobBuildRun, message string) {
+	instance.Status.Status = jenkinsApi.JobBuildRunStatusInvalidDependencies
+	instance.Status.Message = message
+}
+
+func (r *Reconcile) getPollInterval() time.Duration {
+	if r.pollInterval == 0 {
+		return retryInterval
+	}
+
+	return r.pollInterval
+}
+
+func tryToReconcile(
+	instance *jenkinsApi.JenkinsJobBuildRun,
+	jc jenkins.ClientInterface,
+	pollInterval time.Duration,
+) (time.Duration, error) {
 	job, err := jc.GetJobByName(instance.Spec.JobPath) // check if job exists
 	if err != nil {
 		if helper.JenkinsIsNotFoundErr(err) {
@@ -140,11 +323,11 @@ func tryToReconcile(instance *jenkinsApi.JenkinsJobBuildRun, jc jenkins.ClientIn
 
 	// job exists, and it's already in queue, stop here and check later after specified interval
 	if job.Raw.InQueue {
-		return retryInterval, nil
+		return pollInterval, nil
 	}
 
 	// check latest job build
-	interval, err := checkLastBuild(job, instance, jc)
+	interval, err := checkLastBuild(job, instance, jc, pollInterval)
 	if err != nil {
 		return 0, fmt.Errorf("failed to check latest build: %w", err)
 	}
@@ -153,13 +336,13 @@ func tryToReconcile(instance *jenkinsApi.JenkinsJobBuildRun, jc jenkins.ClientIn
 }
 
 func checkLastBuild(job *gojenkins.Job, instance *jenkinsApi.JenkinsJobBuildRun,
-	jc jenkins.ClientInterface,
+	jc jenkins.ClientInterface, pollInterval time.Duration,
 ) (time.Duration, error) {
 	build, err := jc.GetLastBuild(job)
 	if err != nil {
 		// job does not have any builds so we can trigger new one
 		if helper.JenkinsIsNotFoundErr(err) {
-			return retryInterval, triggerNewBuild(instance, jc, jenkinsApi.JobBuildRunStatusCreated)
+			return pollInterval, triggerNewBuild(instance, jc, jenkinsApi.JobBuildRunStatusCreated)
 		}
 
 		// unknown error
@@ -168,7 +351,7 @@ func checkLastBuild(job *gojenkins.Job, instance *jenkinsApi.JenkinsJobBuildRun,
 
 	// check if latest build already running
 	if jc.BuildIsRunning(build) {
-		return retryInterval, nil // latest build already running, stop here and check later after specified interval
+		return pollInterval, nil // latest build already running, stop here and check later after specified interval
 	}
 
 	// if job has latest build we must check if it was created by this controller
@@ -181,7 +364,7 @@ func checkLastBuild(job *gojenkins.Job, instance *jenkinsApi.JenkinsJobBuildRun,
 
 		// build was not finished with success, so we must check how many times we already started it
 		if instance.Spec.Retry > instance.Status.Launches { // launches is less than amount of specified retries
-			return retryInterval, triggerNewBuild(instance, jc, jenkinsApi.JobBuildRunStatusRetrying)
+			return pollInterval, triggerNewBuild(instance, jc, jenkinsApi.JobBuildRunStatusRetrying)
 		}
 
 		// we reach amount of specified retries so job is failed, exit
@@ -191,7 +374,7 @@ func checkLastBuild(job *gojenkins.Job, instance *jenkinsApi.JenkinsJobBuildRun,
 	}
 
 	// latest job was not created by this controller so we can trigger a new one
-	return retryInterval, triggerNewBuild(instance, jc, jenkinsApi.JobBuildRunStatusCreated)
+	return pollInterval, triggerNewBuild(instance, jc, jenkinsApi.JobBuildRunStatusCreated)
 }
 
 func triggerNewBuild(
@@ -199,7 +382,7 @@ func triggerNewBuild(
 	jc jenkins.ClientInterface,
 	status string,
 ) error {
-	buildNumber, err := jc.BuildJob(instance.Spec.JobPath, instance.Spec.Params)
+	buildNumber, err := jc.BuildJob(instance.Spec.JobPath, instance.GetBuildParams())
 	if err != nil {
 		return fmt.Errorf("failed to build job: %w", err)
 	}
@@ -408,3 +408,221 @@ func TestReconcile_ReconcileNewBuild_FailureGetLastBuild(t *testing.T) {
 
 	require.Contains(t, lastErr.Error(), "last build fatal")
 }
+
+func TestReconcile_ReconcileWaitingForUpstream(t *testing.T) {
+	upstream := getTestJenkinsJobBuildRun()
+	upstream.Name = "upstream"
+	upstream.Status.Status = jenkinsApi.JobBuildRunStatusCreated
+
+	jbr := getTestJenkinsJobBuildRun()
+	jbr.Status.BuildNumber = 0
+	jbr.Spec.DependsOn = []jenkinsApi.JenkinsJobBuildRunDependency{{Name: upstream.Name}}
+
+	s := scheme.Scheme
+	s.AddKnownTypes(v1.SchemeGroupVersion, jbr)
+
+	k8sClient := fake.NewClientBuilder().WithRuntimeObjects(jbr, upstream).Build()
+
+	r := Reconcile{
+		client: k8sClient,
+		log:    &helper.LoggerMock{},
+	}
+
+	req := reconcile.Request{
+		NamespacedName: types.NamespacedName{Namespace: jbr.Namespace, Name: jbr.Name},
+	}
+
+	res, err := r.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, retryInterval, res.RequeueAfter)
+
+	var checkJenkinsJobBuildRun jenkinsApi.JenkinsJobBuildRun
+
+	require.NoError(t, k8sClient.Get(context.Background(), req.NamespacedName, &checkJenkinsJobBuildRun))
+	require.Equal(t, jenkinsApi.JobBuildRunStatusWaiting, checkJenkinsJobBuildRun.Status.Status)
+	require.Contains(t, checkJenkinsJobBuildRun.Status.Message, upstream.Name)
+}
+
+func TestReconcile_ReconcileUpstreamFailed(t *testing.T) {
+	upstream := getTestJenkinsJobBuildRun()
+	upstream.Name = "upstream"
+	upstream.Status.Status = jenkinsApi.JobBuildRunStatusFailed
+
+	jbr := getTestJenkinsJobBuildRun()
+	jbr.Status.BuildNumber = 0
+	jbr.Spec.DependsOn = []jenkinsApi.JenkinsJobBuildRunDependency{{Name: upstream.Name}}
+
+	s := scheme.Scheme
+	s.AddKnownTypes(v1.SchemeGroupVersion, jbr)
+
+	k8sClient := fake.NewClientBuilder().WithRuntimeObjects(jbr, upstream).Build()
+
+	r := Reconcile{
+		client: k8sClient,
+		log:    &helper.LoggerMock{},
+	}
+
+	req := reconcile.Request{
+		NamespacedName: types.NamespacedName{Namespace: jbr.Namespace, Name: jbr.Name},
+	}
+
+	res, err := r.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+	require.Empty(t, res.RequeueAfter)
+
+	var checkJenkinsJobBuildRun jenkinsApi.JenkinsJobBuildRun
+
+	require.NoError(t, k8sClient.Get(context.Background(), req.NamespacedName, &checkJenkinsJobBuildRun))
+	require.Equal(t, jenkinsApi.JobBuildRunStatusUpstreamFailed, checkJenkinsJobBuildRun.Status.Status)
+}
+
+func TestReconcile_ReconcileUpstreamCompleted(t *testing.T) {
+	upstream := getTestJenkinsJobBuildRun()
+	upstream.Name = "upstream"
+	upstream.Status.Status = jenkinsApi.JobBuildRunStatusCompleted
+	upstream.Status.BuildNumber = 42
+
+	jbr := getTestJenkinsJobBuildRun()
+	jbr.Status.BuildNumber = 0
+	jbr.Spec.DependsOn = []jenkinsApi.JenkinsJobBuildRunDependency{
+		{Name: upstream.Name, BuildNumberParam: "UPSTREAM_BUILD"},
+	}
+
+	s := scheme.Scheme
+	s.AddKnownTypes(v1.SchemeGroupVersion, jbr)
+
+	k8sClient := fake.NewClientBuilder().WithRuntimeObjects(jbr, upstream).Build()
+	jClient := jenkins.ClientMock{}
+	jBuilder := jenkins.ClientBuilderMock{}
+	jBuilder.On("MakeNewClient", jbr.Spec.OwnerName).Return(&jClient, nil)
+
+	job := gojenkins.Job{
+		Raw: &gojenkins.JobResponse{
+			InQueue: false,
+		},
+	}
+	jClient.On("GetJobByName", "path/job").Return(&job, nil)
+	jClient.On("GetLastBuild", &job).Return(nil, errors.New("404"))
+
+	var buildNum int64 = 1
+
+	jClient.On("BuildJob", jbr.Spec.JobPath, map[string]string{"UPSTREAM_BUILD": "42"}).Return(&buildNum, nil)
+
+	r := Reconcile{
+		client:               k8sClient,
+		jenkinsClientFactory: &jBuilder,
+		log:                  &helper.LoggerMock{},
+	}
+
+	req := reconcile.Request{
+		NamespacedName: types.NamespacedName{Namespace: jbr.Namespace, Name: jbr.Name},
+	}
+
+	_, err := r.Reconcile(context.Background(), req)
+	require.NoError(t, err)
+
+	var checkJenkinsJobBuildRun jenkinsApi.JenkinsJobBuildRun
+
+	require.NoError(t, k8sClient.Get(context.Background(), req.NamespacedName, &checkJenkinsJobBuildRun))
+	require.Equal(t, jenkinsApi.JobBuildRunStatusCreated, checkJenkinsJobBuildRun.Status.Status)
+	require.Equal(t, int64(42), checkJenkinsJobBuildRun.Status.UpstreamBuilds[upstream.Name])
+	jClient.AssertExpectations(t)
+}
+
+func TestReconcile_ReconcileInvalidDependencies(t *testing.T) {
+	tests := []struct {
+		name        string
+		dependsOn   []string
+		created     time.Time
+		upstreams   map[string][]string
+		wantStatus  string
+		wantMessage string
+	}{
+		{
+			name:        "self dependency",
+			dependsOn:   []string{"run1"},
+			created:     time.Now(),
+			wantStatus:  jenkinsApi.JobBuildRunStatusInvalidDependencies,
+			wantMessage: "run run1 depends on itself",
+		},
+		{
+			name:        "dependency cycle",
+			dependsOn:   []string{"upstream"},
+			created:     time.Now(),
+			upstreams:   map[string][]string{"upstream": {"root"}, "root": {"run1"}},
+			wantStatus:  jenkinsApi.JobBuildRunStatusInvalidDependencies,
+			wantMessage: "dependency cycle: run1 -> upstream -> root -> run1",
+		},
+		{
+			name:        "missing upstream",
+			dependsOn:   []string{"upstream"},
+			created:     time.Now().Add(-missingUpstreamTimeout - time.Minute),
+			wantStatus:  jenkinsApi.JobBuildRunStatusInvalidDependencies,
+			wantMessage: "upstream run upstream does not exist",
+		},
+		{
+			name:        "upstream is not created yet",
+			dependsOn:   []string{"upstream"},
+			created:     time.Now(),
+			wantStatus:  jenkinsApi.JobBuildRunStatusWaiting,
+			wantMessage: "waiting for upstream runs: upstream",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			jbr := getTestJenkinsJobBuildRun()
+			jbr.CreationTimestamp = metav1.NewTime(tt.created)
+			jbr.Status.BuildNumber = 0
+
+			for _, dep := range tt.dependsOn {
+				jbr.Spec.DependsOn = append(jbr.Spec.DependsOn, jenkinsApi.JenkinsJobBuildRunDependency{Name: dep})
+			}
+
+			s := scheme.Scheme
+			s.AddKnownTypes(v1.SchemeGroupVersion, jbr)
+
+			builder := fake.NewClientBuilder().WithRuntimeObjects(jbr)
+
+			for name, deps := range tt.upstreams {
+				upstream := getTestJenkinsJobBuildRun()
+				upstream.Name = name
+				upstream.Status.Status = jenkinsApi.JobBuildRunStatusWaiting
+
+				for _, dep := range deps {
+					upstream.Spec.DependsOn = append(upstream.Spec.DependsOn, jenkinsApi.JenkinsJobBuildRunDependency{Name: dep})
+				}
+
+				builder = builder.WithRuntimeObjects(upstream)
+			}
+
+			k8sClient := builder.Build()
+
+			r := Reconcile{
+				client: k8sClient,
+				log:    &helper.LoggerMock{},
+			}
+
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: jbr.Namespace, Name: jbr.Name},
+			}
+
+			_, err := r.Reconcile(context.Background(), req)
+			require.NoError(t, err)
+
+			var checkJenkinsJobBuildRun jenkinsApi.JenkinsJobBuildRun
+
+			require.NoError(t, k8sClient.Get(context.Background(), req.NamespacedName, &checkJenkinsJobBuildRun))
+			require.Equal(t, tt.wantStatus, checkJenkinsJobBuildRun.Status.Status)
+			require.Equal(t, tt.wantMessage, checkJenkinsJobBuildRun.Status.Message)
+
+			res, err := r.Reconcile(context.Background(), req)
+			require.NoError(t, err)
+
+			if tt.wantStatus == jenkinsApi.JobBuildRunStatusInvalidDependencies {
+				require.Empty(t, res.RequeueAfter)
+			}
+		})
+	}
+}
@@ -0,0 +1,401 @@
+package jenkins_multibranchpipeline
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/helper"
+)
+
+const (
+	multibranchClass  = "org.jenkinsci.plugins.workflow.multibranch.WorkflowMultiBranchProject"
+	organizationClass = "jenkins.branch.OrganizationFolder"
+
+	// gitHubPackage is the package of the GitHub branch source plugin, underscores are doubled in the element names.
+	gitHubPackage        = "org.jenkinsci.plugins.github_branch_source"
+	gitHubElementPackage = "org.jenkinsci.plugins.github__branch__source"
+	gitPackage           = "jenkins.plugins.git"
+
+	ownerReference = "../.."
+
+	defaultScriptPath   = "Jenkinsfile"
+	defaultScanInterval = 24 * time.Hour
+	minScanInterval     = time.Minute
+	defaultIncludes     = "*"
+
+	// strategy IDs of the GitHub discovery traits.
+	branchesExcludingPullRequests = 1
+	allBranches                   = 3
+)
+
+var pullRequestStrategies = map[string]int{
+	jenkinsApi.PullRequestStrategyMerge: 1,
+	jenkinsApi.PullRequestStrategyHead:  2,
+	jenkinsApi.PullRequestStrategyBoth:  3,
+}
+
+type classAttr struct {
+	Class string `xml:"class,attr"`
+}
+
+type ownerXML struct {
+	Class     string `xml:"class,attr,omitempty"`
+	Reference string `xml:"reference,attr"`
+}
+
+type ownerHolderXML struct {
+	Class string   `xml:"class,attr"`
+	Owner ownerXML `xml:"owner"`
+}
+
+type orphanedItemStrategyXML struct {
+	Class             string `xml:"class,attr"`
+	PruneDeadBranches bool   `xml:"pruneDeadBranches"`
+	DaysToKeep        int32  `xml:"daysToKeep"`
+	NumToKeep         int32  `xml:"numToKeep"`
+}
+
+type periodicTriggerXML struct {
+	XMLName  xml.Name `xml:"com.cloudbees.hudson.plugins.folder.computed.PeriodicFolderTrigger"`
+	Spec     string   `xml:"spec"`
+	Interval int64    `xml:"interval"`
+}
+
+type triggersXML struct {
+	Trigger periodicTriggerXML
+}
+
+// computedFolderXML is the configuration shared by the multibranch pipeline and the organization folder.
+type computedFolderXML struct {
+	Description          string                  `xml:"description"`
+	Properties           struct{}                `xml:"properties"`
+	FolderViews          ownerHolderXML          `xml:"folderViews"`
+	HealthMetrics        struct{}                `xml:"healthMetrics"`
+	Icon                 ownerHolderXML          `xml:"icon"`
+	OrphanedItemStrategy orphanedItemStrategyXML `xml:"orphanedItemStrategy"`
+	Triggers             triggersXML             `xml:"triggers"`
+}
+
+// traitXML is the discovery trait element named after the trait class.
+type traitXML struct {
+	XMLName    xml.Name
+	StrategyID int        `xml:"strategyId,omitempty"`
+	Trust      *classAttr `xml:"trust,omitempty"`
+	Includes   *string    `xml:"includes,omitempty"`
+	Excludes   *string    `xml:"excludes,omitempty"`
+}
+
+type traitsXML struct {
+	Traits []traitXML
+}
+
+type scmSourceXML struct {
+	Class         string    `xml:"class,attr"`
+	ID            string    `xml:"id"`
+	Remote        string    `xml:"remote,omitempty"`
+	APIURI        string    `xml:"apiUri,omitempty"`
+	CredentialsID string    `xml:"credentialsId,omitempty"`
+	RepoOwner     string    `xml:"repoOwner,omitempty"`
+	Repository    string    `xml:"repository,omitempty"`
+	Traits        traitsXML `xml:"traits"`
+}
+
+type branchSourceXML struct {
+	XMLName  xml.Name     `xml:"jenkins.branch.BranchSource"`
+	Source   scmSourceXML `xml:"source"`
+	Strategy struct {
+		Class      string    `xml:"class,attr"`
+		Properties classAttr `xml:"properties"`
+	} `xml:"strategy"`
+}
+
+type sourcesXML struct {
+	Class string            `xml:"class,attr"`
+	Data  []branchSourceXML `xml:"data>jenkins.branch.BranchSource"`
+	Owner ownerXML          `xml:"owner"`
+}
+
+type branchFactoryXML struct {
+	Class      string   `xml:"class,attr"`
+	Owner      ownerXML `xml:"owner"`
+	ScriptPath string   `xml:"scriptPath"`
+}
+
+type multibranchXML struct {
+	XMLName xml.Name `xml:"org.jenkinsci.plugins.workflow.multibranch.WorkflowMultiBranchProject"`
+	computedFolderXML
+	Sources sourcesXML       `xml:"sources"`
+	Factory branchFactoryXML `xml:"factory"`
+}
+
+type navigatorXML struct {
+	XMLName       xml.Name
+	RepoOwner     string    `xml:"repoOwner"`
+	APIURI        string    `xml:"apiUri,omitempty"`
+	CredentialsID string    `xml:"credentialsId,omitempty"`
+	Traits        traitsXML `xml:"traits"`
+}
+
+type projectFactoryXML struct {
+	XMLName    xml.Name `xml:"org.jenkinsci.plugins.workflow.multibranch.WorkflowMultiBranchProjectFactory"`
+	ScriptPath string   `xml:"scriptPath"`
+}
+
+type organizationXML struct {
+	XMLName xml.Name `xml:"jenkins.branch.OrganizationFolder"`
+	computedFolderXML
+	Navigators       navigatorsXML       `xml:"navigators"`
+	ProjectFactories projectFactoriesXML `xml:"projectFactories"`
+}
+
+type navigatorsXML struct {
+	Navigators []navigatorXML
+}
+
+type projectFactoriesXML struct {
+	Factories []projectFactoryXML
+}
+
+// validateSpec returns the problems found in the spec.
+func validateSpec(spec *jenkinsApi.JenkinsMultibranchPipelineSpec) []string {
+	var errs []string
+
+	if spec.Name == "" || strings.Contains(spec.Name, "/") {
+		errs = append(errs, fmt.Sprintf("name %q must be non-empty and must not contain /", spec.Name))
+	}
+
+	src := &spec.Source
+
+	switch src.Type {
+	case jenkinsApi.BranchSourceGit:
+		if src.Remote == "" {
+			errs = append(errs, "source.remote is required for the git source")
+		}
+
+		if spec.Type == jenkinsApi.MultibranchTypeOrganization {
+			errs = append(errs, "the organization folder supports the github source only")
+		}
+
+		if spec.Discovery.PullRequests != "" || spec.Discovery.ForkPullRequests != "" {
+			errs = append(errs, "pull request discovery is supported by the github source only")
+		}
+	case jenkinsApi.BranchSourceGitHub:
+		if src.Owner == "" {
+			errs = append(errs, "source.owner is required for the github source")
+		}
+
+		if src.Repository == "" && spec.Type != jenkinsApi.MultibranchTypeOrganization {
+			errs = append(errs, "source.repository is required for the github multibranch pipeline")
+		}
+	default:
+		errs = append(errs, fmt.Sprintf("unknown source type %q", src.Type))
+	}
+
+	if spec.ScanInterval != "" {
+		interval, err := time.ParseDuration(spec.ScanInterval)
+
+		switch {
+		case err != nil:
+			errs = append(errs, fmt.Sprintf("scanInterval is invalid: %v", err))
+		case interval < minScanInterval:
+			errs = append(errs, fmt.Sprintf("scanInterval must not be less than %v", minScanInterval))
+		}
+	}
+
+	return errs
+}
+
+// renderConfig returns the config.xml of the multibranch pipeline or the organization folder.
+// The spec must be validated before it is rendered.
+func renderConfig(instance *jenkinsApi.JenkinsMultibranchPipeline, credentialsID string) ([]byte, error) {
+	spec := &instance.Spec
+
+	class := multibranchClass
+	if spec.Type == jenkinsApi.MultibranchTypeOrganization {
+		class = organizationClass
+	}
+
+	owner := ownerXML{Class: class, Reference: ownerReference}
+
+	folder := computedFolderXML{
+		Description:          spec.Description,
+		FolderViews:          ownerHolderXML{Class: "jenkins.branch.MultiBranchProjectViewHolder", Owner: owner},
+		Icon:                 ownerHolderXML{Class: "jenkins.branch.MetadataActionFolderIcon", Owner: owner},
+		OrphanedItemStrategy: orphanedItemStrategy(spec.OrphanedItemStrategy),
+		Triggers:             triggersXML{Trigger: periodicTrigger(spec.ScanInterval)},
+	}
+
+	scriptPath := helper.ValueOrDefault(spec.ScriptPath, defaultScriptPath)
+	src := &spec.Source
+	traits := discoveryTraits(src.Type, &spec.Discovery)
+
+	var config interface{}
+
+	if spec.Type == jenkinsApi.MultibranchTypeOrganization {
+		folder.FolderViews = ownerHolderXML{Class: "jenkins.branch.OrganizationFolderViewHolder", Owner: ownerXML{Reference: ownerReference}}
+
+		config = organizationXML{
+			computedFolderXML: folder,
+			Navigators: navigatorsXML{Navigators: []navigatorXML{{
+				XMLName:       xml.Name{Local: gitHubElementPackage + ".GitHubSCMNavigator"},
+				RepoOwner:     src.Owner,
+				APIURI:        src.APIURL,
+				CredentialsID: credentialsID,
+				Traits:        traits,
+			}}},
+			ProjectFactories: projectFactoriesXML{Factories: []projectFactoryXML{{ScriptPath: scriptPath}}},
+		}
+	} else {
+		source := scmSourceXML{
+			ID:            instance.Name,
+			CredentialsID: credentialsID,
+			Traits:        traits,
+		}
+
+		if src.Type == jenkinsApi.BranchSourceGitHub {
+			source.Class = gitHubPackage + ".GitHubSCMSource"
+			source.APIURI = src.APIURL
+			source.RepoOwner = src.Owner
+			source.Repository = src.Repository
+		} else {
+			source.Class = gitPackage + ".GitSCMSource"
+			source.Remote = src.Remote
+		}
+
+		bs := branchSourceXML{Source: source}
+		bs.Strategy.Class = "jenkins.branch.DefaultBranchPropertyStrategy"
+		bs.Strategy.Properties = classAttr{Class: "empty-list"}
+
+		config = multibranchXML{
+			computedFolderXML: folder,
+			Sources: sourcesXML{
+				Class: "jenkins.branch.MultiBranchProject$BranchSourceList",
+				Data:  []branchSourceXML{bs},
+				Owner: owner,
+			},
+			Factory: branchFactoryXML{
+				Class:      "org.jenkinsci.plugins.workflow.multibranch.WorkflowBranchProjectFactory",
+				Owner:      owner,
+				ScriptPath: scriptPath,
+			},
+		}
+	}
+
+	out, err := xml.Marshal(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal job config: %w", err)
+	}
+
+	return out, nil
+}
+
+func orphanedItemStrategy(s *jenkinsApi.OrphanedItemStrategy) orphanedItemStrategyXML {
+	out := orphanedItemStrategyXML{
+		Class:             "com.cloudbees.hudson.plugins.folder.computed.DefaultOrphanedItemStrategy",
+		PruneDeadBranches: true,
+		DaysToKeep:        -1,
+		NumToKeep:         -1,
+	}
+
+	if s == nil {
+		return out
+	}
+
+	if s.Prune != nil {
+		out.PruneDeadBranches = *s.Prune
+	}
+
+	if s.DaysToKeep != nil {
+		out.DaysToKeep = *s.DaysToKeep
+	}
+
+	if s.NumToKeep != nil {
+		out.NumToKeep = *s.NumToKeep
+	}
+
+	return out
+}
+
+// periodicTrigger returns the scan trigger, the crontab only defines how often the interval is checked.
+func periodicTrigger(scanInterval string) periodicTriggerXML {
+	interval := defaultScanInterval
+	if scanInterval != "" {
+		// the interval has been validated.
+		interval, _ = time.ParseDuration(scanInterval)
+	}
+
+	spec := "H * * * *"
+
+	switch {
+	case interval <= 5*time.Minute:
+		spec = "* * * * *"
+	case interval < time.Hour:
+		spec = "H/5 * * * *"
+	}
+
+	return periodicTriggerXML{Spec: spec, Interval: interval.Milliseconds()}
+}
+
+func discoveryTraits(sourceType string, d *jenkinsApi.BranchDiscovery) traitsXML {
+	var traits []traitXML
+
+	discoverBranches := d.Branches == nil || *d.Branches
+
+	if sourceType == jenkinsApi.BranchSourceGitHub {
+		if discoverBranches {
+			strategy := allBranches
+			if d.PullRequests != "" {
+				strategy = branchesExcludingPullRequests
+			}
+
+			traits = append(traits, traitXML{XMLName: gitHubTrait("BranchDiscoveryTrait"), StrategyID: strategy})
+		}
+
+		if d.PullRequests != "" {
+			traits = append(traits, traitXML{
+				XMLName:    gitHubTrait("OriginPullRequestDiscoveryTrait"),
+				StrategyID: pullRequestStrategies[d.PullRequests],
+			})
+		}
+
+		if d.ForkPullRequests != "" {
+			traits = append(traits, traitXML{
+				XMLName:    gitHubTrait("ForkPullRequestDiscoveryTrait"),
+				StrategyID: pullRequestStrategies[d.ForkPullRequests],
+				Trust:      &classAttr{Class: gitHubPackage + ".ForkPullRequestDiscoveryTrait$TrustPermission"},
+			})
+		}
+
+		if d.Tags {
+			traits = append(traits, traitXML{XMLName: gitHubTrait("TagDiscoveryTrait")})
+		}
+	} else {
+		if discoverBranches {
+			traits = append(traits, traitXML{XMLName: xml.Name{Local: gitPackage + ".traits.BranchDiscoveryTrait"}})
+		}
+
+		if d.Tags {
+			traits = append(traits, traitXML{XMLName: xml.Name{Local: gitPackage + ".traits.TagDiscoveryTrait"}})
+		}
+	}
+
+	if d.Includes != "" || d.Excludes != "" {
+		includes := helper.ValueOrDefault(d.Includes, defaultIncludes)
+		excludes := d.Excludes
+
+		traits = append(traits, traitXML{
+			XMLName:  xml.Name{Local: "jenkins.scm.impl.trait.WildcardSCMHeadFilterTrait"},
+			Includes: &includes,
+			Excludes: &excludes,
+		})
+	}
+
+	return traitsXML{Traits: traits}
+}
+
+func gitHubTrait(name string) xml.Name {
+	return xml.Name{Local: gitHubElementPackage + "." + name}
+}
@@ -0,0 +1,156 @@
+package jenkins_multibranchpipeline
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+)
+
+func TestValidateSpec(t *testing.T) {
+	tests := []struct {
+		name string
+		spec jenkinsApi.JenkinsMultibranchPipelineSpec
+		want []string
+	}{
+		{
+			name: "valid git multibranch",
+			spec: jenkinsApi.JenkinsMultibranchPipelineSpec{
+				Name:   "app",
+				Source: jenkinsApi.BranchSource{Type: jenkinsApi.BranchSourceGit, Remote: "https://git/app.git"},
+			},
+		},
+		{
+			name: "valid github organization",
+			spec: jenkinsApi.JenkinsMultibranchPipelineSpec{
+				Name:         "team",
+				Type:         jenkinsApi.MultibranchTypeOrganization,
+				Source:       jenkinsApi.BranchSource{Type: jenkinsApi.BranchSourceGitHub, Owner: "epam"},
+				Discovery:    jenkinsApi.BranchDiscovery{PullRequests: jenkinsApi.PullRequestStrategyMerge},
+				ScanInterval: "4h",
+			},
+		},
+		{
+			name: "invalid git",
+			spec: jenkinsApi.JenkinsMultibranchPipelineSpec{
+				Name:      "team/app",
+				Type:      jenkinsApi.MultibranchTypeOrganization,
+				Source:    jenkinsApi.BranchSource{Type: jenkinsApi.BranchSourceGit},
+				Discovery: jenkinsApi.BranchDiscovery{ForkPullRequests: jenkinsApi.PullRequestStrategyHead},
+			},
+			want: []string{
+				`name "team/app" must be non-empty and must not contain /`,
+				"source.remote is required for the git source",
+				"the organization folder supports the github source only",
+				"pull request discovery is supported by the github source only",
+			},
+		},
+		{
+			name: "invalid github",
+			spec: jenkinsApi.JenkinsMultibranchPipelineSpec{
+				Name:         "app",
+				Source:       jenkinsApi.BranchSource{Type: jenkinsApi.BranchSourceGitHub},
+				ScanInterval: "30s",
+			},
+			want: []string{
+				"source.owner is required for the github source",
+				"source.repository is required for the github multibranch pipeline",
+				"scanInterval must not be less than 1m0s",
+			},
+		},
+		{
+			name: "unknown source",
+			spec: jenkinsApi.JenkinsMultibranchPipelineSpec{
+				Name:         "app",
+				Source:       jenkinsApi.BranchSource{Type: "svn"},
+				ScanInterval: "1d",
+			},
+			want: []string{
+				`unknown source type "svn"`,
+				`scanInterval is invalid: time: unknown unit "d" in duration "1d"`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, validateSpec(&tt.spec))
+		})
+	}
+}
+
+func TestRenderConfig_Git(t *testing.T) {
+	days := int32(7)
+	instance := &jenkinsApi.JenkinsMultibranchPipeline{
+		ObjectMeta: metav1.ObjectMeta{Name: "app"},
+		Spec: jenkinsApi.JenkinsMultibranchPipelineSpec{
+			Name:                 "app",
+			Description:          "App branches",
+			Source:               jenkinsApi.BranchSource{Type: jenkinsApi.BranchSourceGit, Remote: "https://git/app.git"},
+			ScriptPath:           "ci/Jenkinsfile",
+			Discovery:            jenkinsApi.BranchDiscovery{Tags: true},
+			OrphanedItemStrategy: &jenkinsApi.OrphanedItemStrategy{DaysToKeep: &days},
+		},
+	}
+
+	config, err := renderConfig(instance, "git-credentials")
+	require.NoError(t, err)
+
+	for _, part := range []string{
+		`<org.jenkinsci.plugins.workflow.multibranch.WorkflowMultiBranchProject><description>App branches</description>`,
+		`<pruneDeadBranches>true</pruneDeadBranches><daysToKeep>7</daysToKeep><numToKeep>-1</numToKeep>`,
+		`<spec>H * * * *</spec><interval>86400000</interval>`,
+		`<source class="jenkins.plugins.git.GitSCMSource"><id>app</id><remote>https://git/app.git</remote>` +
+			`<credentialsId>git-credentials</credentialsId>`,
+		`<traits><jenkins.plugins.git.traits.BranchDiscoveryTrait></jenkins.plugins.git.traits.BranchDiscoveryTrait>` +
+			`<jenkins.plugins.git.traits.TagDiscoveryTrait></jenkins.plugins.git.traits.TagDiscoveryTrait></traits>`,
+		`<scriptPath>ci/Jenkinsfile</scriptPath></factory>`,
+	} {
+		require.Contains(t, string(config), part)
+	}
+}
+
+func TestRenderConfig_GitHubOrganization(t *testing.T) {
+	prune := false
+	instance := &jenkinsApi.JenkinsMultibranchPipeline{
+		ObjectMeta: metav1.ObjectMeta{Name: "team"},
+		Spec: jenkinsApi.JenkinsMultibranchPipelineSpec{
+			Name:   "team",
+			Type:   jenkinsApi.MultibranchTypeOrganization,
+			Source: jenkinsApi.BranchSource{Type: jenkinsApi.BranchSourceGitHub, Owner: "epam", APIURL: "https://ghe/api/v3"},
+			Discovery: jenkinsApi.BranchDiscovery{
+				PullRequests:     jenkinsApi.PullRequestStrategyBoth,
+				ForkPullRequests: jenkinsApi.PullRequestStrategyHead,
+				Excludes:         "wip-*",
+			},
+			OrphanedItemStrategy: &jenkinsApi.OrphanedItemStrategy{Prune: &prune},
+			ScanInterval:         "5m",
+		},
+	}
+
+	config, err := renderConfig(instance, "")
+	require.NoError(t, err)
+
+	for _, part := range []string{
+		`<jenkins.branch.OrganizationFolder><description></description>`,
+		`<folderViews class="jenkins.branch.OrganizationFolderViewHolder"><owner reference="../.."></owner></folderViews>`,
+		`<pruneDeadBranches>false</pruneDeadBranches>`,
+		`<spec>* * * * *</spec><interval>300000</interval>`,
+		`<navigators><org.jenkinsci.plugins.github__branch__source.GitHubSCMNavigator><repoOwner>epam</repoOwner>` +
+			`<apiUri>https://ghe/api/v3</apiUri><traits>`,
+		`<org.jenkinsci.plugins.github__branch__source.BranchDiscoveryTrait><strategyId>1</strategyId>`,
+		`<org.jenkinsci.plugins.github__branch__source.OriginPullRequestDiscoveryTrait><strategyId>3</strategyId>`,
+		`<org.jenkinsci.plugins.github__branch__source.ForkPullRequestDiscoveryTrait><strategyId>2</strategyId>` +
+			`<trust class="org.jenkinsci.plugins.github_branch_source.ForkPullRequestDiscoveryTrait$TrustPermission">`,
+		`<jenkins.scm.impl.trait.WildcardSCMHeadFilterTrait><includes>*</includes><excludes>wip-*</excludes>`,
+		`<projectFactories><org.jenkinsci.plugins.workflow.multibranch.WorkflowMultiBranchProjectFactory>` +
+			`<scriptPath>Jenkinsfile</scriptPath>`,
+	} {
+		require.Contains(t, string(config), part)
+	}
+
+	require.NotContains(t, string(config), "credentialsId")
+}
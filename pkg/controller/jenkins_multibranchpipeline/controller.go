@@ -0,0 +1,267 @@
+package jenkins_multibranchpipeline
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/client/jenkins"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/helper"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/service/platform"
+)
+
+const (
+	finalizerName = "jenkinsmultibranchpipeline.jenkins.finalizer.name"
+
+	// syncInterval is how often the job is saved again to revert the changes made in Jenkins.
+	syncInterval = 10 * time.Minute
+
+	// credentialsIDKey is the key of the service account Secret which overrides the credentials ID.
+	credentialsIDKey = "id"
+)
+
+type Reconcile struct {
+	client               client.Client
+	log                  logr.Logger
+	jenkinsClientFactory jenkins.ClientFactory
+	now                  func() time.Time
+}
+
+func NewReconciler(k8sCl client.Client, logf logr.Logger, ps platform.PlatformService) *Reconcile {
+	return &Reconcile{
+		client:               k8sCl,
+		log:                  logf.WithName("controller_jenkins_multibranch_pipeline"),
+		jenkinsClientFactory: jenkins.MakeClientBuilder(ps, k8sCl),
+		now:                  time.Now,
+	}
+}
+
+func (r *Reconcile) SetupWithManager(mgr ctrl.Manager) error {
+	p := predicate.Funcs{
+		UpdateFunc: specUpdated,
+	}
+
+	if err := ctrl.NewControllerManagedBy(mgr).
+		For(&jenkinsApi.JenkinsMultibranchPipeline{}, builder.WithPredicates(p)).
+		Complete(r); err != nil {
+		return fmt.Errorf("failed to create new managed controller: %w", err)
+	}
+
+	return nil
+}
+
+func specUpdated(e event.UpdateEvent) bool {
+	oldObject, ok := e.ObjectOld.(*jenkinsApi.JenkinsMultibranchPipeline)
+	if !ok {
+		return false
+	}
+
+	newObject, ok := e.ObjectNew.(*jenkinsApi.JenkinsMultibranchPipeline)
+	if !ok {
+		return false
+	}
+
+	return !reflect.DeepEqual(oldObject.Spec, newObject.Spec) ||
+		(oldObject.GetDeletionTimestamp().IsZero() && !newObject.GetDeletionTimestamp().IsZero())
+}
+
+func (r *Reconcile) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	reqLogger := r.log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+	reqLogger.Info("Reconciling JenkinsMultibranchPipeline has been started")
+
+	instance := new(jenkinsApi.JenkinsMultibranchPipeline)
+
+	if err := r.client.Get(ctx, request.NamespacedName, instance); err != nil {
+		if k8serrors.IsNotFound(err) {
+			reqLogger.Info("instance not found")
+
+			return reconcile.Result{}, nil
+		}
+
+		return reconcile.Result{}, fmt.Errorf("failed to get JenkinsMultibranchPipeline instance: %w", err)
+	}
+
+	if err := r.tryToReconcile(ctx, instance); err != nil {
+		r.log.Error(err, "error during reconciliation", "instance", instance)
+		r.updateInstanceStatus(ctx, instance, err.Error())
+
+		return reconcile.Result{RequeueAfter: helper.DefaultRequeueTime * time.Second}, nil
+	}
+
+	r.updateInstanceStatus(ctx, instance, helper.StatusSuccess)
+
+	reqLogger.Info("Reconciling JenkinsMultibranchPipeline has been finished")
+
+	if !instance.GetDeletionTimestamp().IsZero() {
+		return reconcile.Result{}, nil
+	}
+
+	return reconcile.Result{RequeueAfter: syncInterval}, nil
+}
+
+func (r *Reconcile) tryToReconcile(ctx context.Context, instance *jenkinsApi.JenkinsMultibranchPipeline) error {
+	jc, err := r.jenkinsClientFactory.MakeNewClient(&instance.ObjectMeta, instance.Spec.OwnerName)
+	if err != nil {
+		return fmt.Errorf("failed to create gojenkins client: %w", err)
+	}
+
+	refs := append([]metav1.OwnerReference(nil), instance.GetOwnerReferences()...)
+
+	if instance.GetDeletionTimestamp().IsZero() {
+		if err = r.syncJob(ctx, instance, jc); err != nil {
+			return err
+		}
+	}
+
+	updateNeeded, err := helper.TryToDelete(instance, finalizerName, makeDeletionFunc(instance, jc))
+	if err != nil {
+		return fmt.Errorf("failed to delete instance: %w", err)
+	}
+
+	if updateNeeded || !reflect.DeepEqual(refs, instance.GetOwnerReferences()) {
+		return helper.UpdateKeepingStatus(ctx, r.client, instance, &instance.Status)
+	}
+
+	return nil
+}
+
+// syncJob saves the job configuration, recreates the job if it has been moved, renamed or its type has been changed,
+// and schedules the scan of the created job or the requested one.
+func (r *Reconcile) syncJob(
+	ctx context.Context,
+	instance *jenkinsApi.JenkinsMultibranchPipeline,
+	jc jenkins.ClientInterface,
+) error {
+	if errs := validateSpec(&instance.Spec); len(errs) > 0 {
+		return fmt.Errorf("invalid spec: %s", strings.Join(errs, "; "))
+	}
+
+	folder, err := helper.SetFolderOrJenkinsOwner(r.client, instance, instance.Spec.FolderName, instance.Spec.OwnerName)
+	if err != nil {
+		return err
+	}
+
+	credentialsID, err := r.credentialsID(ctx, instance)
+	if err != nil {
+		return err
+	}
+
+	config, err := renderConfig(instance, credentialsID)
+	if err != nil {
+		return err
+	}
+
+	jobType := jobTypeOf(instance)
+	status := instance.Status
+
+	if status.JobName != "" &&
+		(status.JobName != instance.Spec.Name || status.Folder != folder || status.Type != jobType) {
+		if err = jc.DeleteJob(status.Folder, status.JobName); err != nil {
+			return fmt.Errorf("failed to delete old job %s: %w", status.JobName, err)
+		}
+
+		instance.Status.JobName = ""
+		instance.Status.Folder = ""
+		instance.Status.Type = ""
+	}
+
+	created, err := jc.SaveJobConfig(folder, instance.Spec.Name, config)
+	if err != nil {
+		return fmt.Errorf("failed to save job: %w", err)
+	}
+
+	instance.Status.JobName = instance.Spec.Name
+	instance.Status.Folder = folder
+	instance.Status.Type = jobType
+
+	if !created && (instance.Spec.ScanRequest == "" || instance.Spec.ScanRequest == status.ScanRequest) {
+		return nil
+	}
+
+	if err = jc.ScheduleScan(folder, instance.Spec.Name); err != nil {
+		return fmt.Errorf("failed to schedule scan: %w", err)
+	}
+
+	instance.Status.ScanRequest = instance.Spec.ScanRequest
+	instance.Status.LastScanTime = &metav1.Time{Time: r.now()}
+
+	r.log.Info("scan has been scheduled", "instance", instance.Name, "job", instance.Spec.Name)
+
+	return nil
+}
+
+// credentialsID returns the ID of the credentials created in Jenkins for the service account of the source.
+func (r *Reconcile) credentialsID(ctx context.Context, instance *jenkinsApi.JenkinsMultibranchPipeline) (string, error) {
+	name := instance.Spec.Source.ServiceAccountName
+	if name == "" {
+		return "", nil
+	}
+
+	sa := &jenkinsApi.JenkinsServiceAccount{}
+
+	if err := r.client.Get(ctx, types.NamespacedName{Namespace: instance.Namespace, Name: name}, sa); err != nil {
+		return "", fmt.Errorf("failed to get service account %s: %w", name, err)
+	}
+
+	if !sa.Status.Created {
+		return "", fmt.Errorf("credentials of service account %s are not created yet", name)
+	}
+
+	secret := &corev1.Secret{}
+
+	if err := r.client.Get(ctx, types.NamespacedName{Namespace: instance.Namespace, Name: sa.Spec.Credentials}, secret); err != nil {
+		return "", fmt.Errorf("failed to get secret %s: %w", sa.Spec.Credentials, err)
+	}
+
+	// the credentials are created with the ID from the Secret or with the Secret name.
+	if id := strings.TrimSpace(string(secret.Data[credentialsIDKey])); id != "" {
+		return id, nil
+	}
+
+	return sa.Spec.Credentials, nil
+}
+
+func jobTypeOf(instance *jenkinsApi.JenkinsMultibranchPipeline) string {
+	return helper.ValueOrDefault(instance.Spec.Type, jenkinsApi.MultibranchTypePipeline)
+}
+
+func makeDeletionFunc(instance *jenkinsApi.JenkinsMultibranchPipeline, jc jenkins.ClientInterface) func() error {
+	return func() error {
+		if instance.Status.JobName == "" {
+			return nil
+		}
+
+		if err := jc.DeleteJob(instance.Status.Folder, instance.Status.JobName); err != nil {
+			return fmt.Errorf("failed to delete job: %w", err)
+		}
+
+		return nil
+	}
+}
+
+func (r *Reconcile) updateInstanceStatus(
+	ctx context.Context,
+	instance *jenkinsApi.JenkinsMultibranchPipeline,
+	statusValue string,
+) {
+	instance.Status.Value = statusValue
+
+	if err := r.client.Status().Update(ctx, instance); err != nil {
+		r.log.Error(err, "unable to update status", "instance", instance)
+	}
+}
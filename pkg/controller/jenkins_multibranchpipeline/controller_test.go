@@ -0,0 +1,295 @@
+package jenkins_multibranchpipeline
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	pmock "github.com/epam/edp-jenkins-operator/v2/mock/platform"
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/client/jenkins"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/helper"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/helper/testhelper"
+)
+
+const (
+	name      = "app"
+	namespace = "ns"
+)
+
+var testNow = time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+
+func getTestPipeline() *jenkinsApi.JenkinsMultibranchPipeline {
+	return &jenkinsApi.JenkinsMultibranchPipeline{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: jenkinsApi.JenkinsMultibranchPipelineSpec{
+			Name:   "app-branches",
+			Source: jenkinsApi.BranchSource{Type: jenkinsApi.BranchSourceGit, Remote: "https://git/app.git"},
+		},
+	}
+}
+
+func newTestReconcile(t *testing.T, jClient *jenkins.ClientMock, objects ...client.Object) (*Reconcile, client.Client) {
+	t.Helper()
+
+	jenkinsInstance := &jenkinsApi.Jenkins{
+		ObjectMeta: metav1.ObjectMeta{Name: "jenkins", Namespace: namespace},
+	}
+
+	k8sClient := testhelper.NewFakeClient(t, append(objects, jenkinsInstance)...)
+
+	return &Reconcile{
+		client:               k8sClient,
+		jenkinsClientFactory: testhelper.NewClientFactory(jClient),
+		log:                  &helper.LoggerMock{},
+		now:                  func() time.Time { return testNow },
+	}, k8sClient
+}
+
+func TestReconcile_Reconcile(t *testing.T) {
+	instance := getTestPipeline()
+
+	config, err := renderConfig(instance, "")
+	require.NoError(t, err)
+
+	jClient := jenkins.ClientMock{}
+	jClient.On("SaveJobConfig", "", "app-branches", config).Return(true, nil)
+	jClient.On("ScheduleScan", "", "app-branches").Return(nil)
+
+	r, k8sClient := newTestReconcile(t, &jClient, instance)
+
+	res := testhelper.Reconcile(t, r, namespace, name)
+	require.Equal(t, syncInterval, res.RequeueAfter)
+	jClient.AssertExpectations(t)
+
+	got := testhelper.Get[jenkinsApi.JenkinsMultibranchPipeline](t, k8sClient, namespace, name)
+	require.Equal(t, helper.StatusSuccess, got.Status.Value)
+	require.Equal(t, "app-branches", got.Status.JobName)
+	require.Equal(t, "", got.Status.Folder)
+	require.Equal(t, jenkinsApi.MultibranchTypePipeline, got.Status.Type)
+	require.True(t, testNow.Equal(got.Status.LastScanTime.Time))
+	require.Equal(t, []string{finalizerName}, got.Finalizers)
+	require.Len(t, got.OwnerReferences, 1)
+	require.Equal(t, "jenkins", got.OwnerReferences[0].Name)
+}
+
+func TestReconcile_Reconcile_ScanRequest(t *testing.T) {
+	instance := getTestPipeline()
+	instance.Finalizers = []string{finalizerName}
+	instance.Spec.ScanRequest = "2"
+	instance.Status = jenkinsApi.JenkinsMultibranchPipelineStatus{
+		JobName:     "app-branches",
+		Type:        jenkinsApi.MultibranchTypePipeline,
+		ScanRequest: "1",
+	}
+
+	jClient := jenkins.ClientMock{}
+	jClient.On("SaveJobConfig", "", "app-branches", mock.Anything).Return(false, nil)
+	jClient.On("ScheduleScan", "", "app-branches").Return(nil)
+
+	r, k8sClient := newTestReconcile(t, &jClient, instance)
+
+	testhelper.Reconcile(t, r, namespace, name)
+	jClient.AssertExpectations(t)
+
+	got := testhelper.Get[jenkinsApi.JenkinsMultibranchPipeline](t, k8sClient, namespace, name)
+	require.Equal(t, helper.StatusSuccess, got.Status.Value)
+	require.Equal(t, "2", got.Status.ScanRequest)
+	require.NotNil(t, got.Status.LastScanTime)
+}
+
+func TestReconcile_Reconcile_NoScan(t *testing.T) {
+	instance := getTestPipeline()
+	instance.Finalizers = []string{finalizerName}
+	instance.Spec.ScanRequest = "1"
+	instance.Status = jenkinsApi.JenkinsMultibranchPipelineStatus{
+		JobName:     "app-branches",
+		Type:        jenkinsApi.MultibranchTypePipeline,
+		ScanRequest: "1",
+	}
+
+	jClient := jenkins.ClientMock{}
+	jClient.On("SaveJobConfig", "", "app-branches", mock.Anything).Return(false, nil)
+
+	r, k8sClient := newTestReconcile(t, &jClient, instance)
+
+	testhelper.Reconcile(t, r, namespace, name)
+	jClient.AssertExpectations(t)
+
+	got := testhelper.Get[jenkinsApi.JenkinsMultibranchPipeline](t, k8sClient, namespace, name)
+	require.Equal(t, helper.StatusSuccess, got.Status.Value)
+	require.Nil(t, got.Status.LastScanTime)
+}
+
+func TestReconcile_Reconcile_TypeChanged(t *testing.T) {
+	instance := getTestPipeline()
+	instance.Finalizers = []string{finalizerName}
+	instance.Spec.Type = jenkinsApi.MultibranchTypeOrganization
+	instance.Spec.Source = jenkinsApi.BranchSource{Type: jenkinsApi.BranchSourceGitHub, Owner: "epam"}
+	instance.Status = jenkinsApi.JenkinsMultibranchPipelineStatus{
+		JobName: "app-branches",
+		Type:    jenkinsApi.MultibranchTypePipeline,
+	}
+
+	jClient := jenkins.ClientMock{}
+	jClient.On("DeleteJob", "", "app-branches").Return(nil)
+	jClient.On("SaveJobConfig", "", "app-branches", mock.Anything).Return(true, nil)
+	jClient.On("ScheduleScan", "", "app-branches").Return(nil)
+
+	r, k8sClient := newTestReconcile(t, &jClient, instance)
+
+	testhelper.Reconcile(t, r, namespace, name)
+	jClient.AssertExpectations(t)
+
+	got := testhelper.Get[jenkinsApi.JenkinsMultibranchPipeline](t, k8sClient, namespace, name)
+	require.Equal(t, helper.StatusSuccess, got.Status.Value)
+	require.Equal(t, jenkinsApi.MultibranchTypeOrganization, got.Status.Type)
+}
+
+func TestReconcile_Reconcile_MovedToFolder(t *testing.T) {
+	folderName := "team"
+
+	instance := getTestPipeline()
+	instance.Finalizers = []string{finalizerName}
+	instance.Spec.FolderName = &folderName
+	instance.Status = jenkinsApi.JenkinsMultibranchPipelineStatus{
+		JobName: "app-branches",
+		Type:    jenkinsApi.MultibranchTypePipeline,
+	}
+	instance.OwnerReferences = []metav1.OwnerReference{
+		{APIVersion: "v2.edp.epam.com/v1", Kind: "Jenkins", Name: "jenkins"},
+	}
+
+	folder := &jenkinsApi.JenkinsFolder{
+		ObjectMeta: metav1.ObjectMeta{Name: "team", Namespace: namespace},
+		Status:     jenkinsApi.JenkinsFolderStatus{Available: true},
+	}
+
+	jClient := jenkins.ClientMock{}
+	jClient.On("DeleteJob", "", "app-branches").Return(nil)
+	jClient.On("SaveJobConfig", "team", "app-branches", mock.Anything).Return(true, nil)
+	jClient.On("ScheduleScan", "team", "app-branches").Return(nil)
+
+	r, k8sClient := newTestReconcile(t, &jClient, instance, folder)
+
+	testhelper.Reconcile(t, r, namespace, name)
+	jClient.AssertExpectations(t)
+
+	got := testhelper.Get[jenkinsApi.JenkinsMultibranchPipeline](t, k8sClient, namespace, name)
+	require.Equal(t, helper.StatusSuccess, got.Status.Value)
+	require.Equal(t, "team", got.Status.Folder)
+	require.Len(t, got.OwnerReferences, 1)
+	require.Equal(t, "JenkinsFolder", got.OwnerReferences[0].Kind)
+}
+
+func TestReconcile_Reconcile_ServiceAccount(t *testing.T) {
+	instance := getTestPipeline()
+	instance.Spec.Source.ServiceAccountName = "git-sa"
+
+	sa := &jenkinsApi.JenkinsServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{Name: "git-sa", Namespace: namespace},
+		Spec:       jenkinsApi.JenkinsServiceAccountSpec{Credentials: "git-secret"},
+		Status:     jenkinsApi.JenkinsServiceAccountStatus{Created: true},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "git-secret", Namespace: namespace},
+		Data:       map[string][]byte{credentialsIDKey: []byte("git-credentials")},
+	}
+
+	config, err := renderConfig(instance, "git-credentials")
+	require.NoError(t, err)
+
+	jClient := jenkins.ClientMock{}
+	jClient.On("SaveJobConfig", "", "app-branches", config).Return(true, nil)
+	jClient.On("ScheduleScan", "", "app-branches").Return(nil)
+
+	r, k8sClient := newTestReconcile(t, &jClient, instance, sa, secret)
+
+	testhelper.Reconcile(t, r, namespace, name)
+	jClient.AssertExpectations(t)
+
+	require.Equal(t, helper.StatusSuccess, testhelper.Get[jenkinsApi.JenkinsMultibranchPipeline](t, k8sClient, namespace, name).Status.Value)
+}
+
+func TestReconcile_Reconcile_ServiceAccountNotCreated(t *testing.T) {
+	instance := getTestPipeline()
+	instance.Spec.Source.ServiceAccountName = "git-sa"
+
+	sa := &jenkinsApi.JenkinsServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{Name: "git-sa", Namespace: namespace},
+		Spec:       jenkinsApi.JenkinsServiceAccountSpec{Credentials: "git-secret"},
+	}
+
+	r, k8sClient := newTestReconcile(t, &jenkins.ClientMock{}, instance, sa)
+
+	res := testhelper.Reconcile(t, r, namespace, name)
+	require.Equal(t, helper.DefaultRequeueTime, int(res.RequeueAfter.Seconds()))
+
+	require.Equal(t, "credentials of service account git-sa are not created yet",
+		testhelper.Get[jenkinsApi.JenkinsMultibranchPipeline](t, k8sClient, namespace, name).Status.Value)
+}
+
+func TestReconcile_Reconcile_InvalidSpec(t *testing.T) {
+	instance := getTestPipeline()
+	instance.Spec.Source.Remote = ""
+
+	r, k8sClient := newTestReconcile(t, &jenkins.ClientMock{}, instance)
+
+	testhelper.Reconcile(t, r, namespace, name)
+
+	require.Equal(t, "invalid spec: source.remote is required for the git source",
+		testhelper.Get[jenkinsApi.JenkinsMultibranchPipeline](t, k8sClient, namespace, name).Status.Value)
+}
+
+func TestReconcile_Reconcile_SaveErr(t *testing.T) {
+	instance := getTestPipeline()
+
+	jClient := jenkins.ClientMock{}
+	jClient.On("SaveJobConfig", "", "app-branches", mock.Anything).Return(false, errors.New("save fatal"))
+
+	r, k8sClient := newTestReconcile(t, &jClient, instance)
+
+	res := testhelper.Reconcile(t, r, namespace, name)
+	require.Equal(t, helper.DefaultRequeueTime, int(res.RequeueAfter.Seconds()))
+
+	require.Equal(t, "failed to save job: save fatal", testhelper.Get[jenkinsApi.JenkinsMultibranchPipeline](t, k8sClient, namespace, name).Status.Value)
+}
+
+func TestReconcile_Reconcile_Delete(t *testing.T) {
+	instance := getTestPipeline()
+	instance.Finalizers = []string{finalizerName}
+	instance.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	instance.Status = jenkinsApi.JenkinsMultibranchPipelineStatus{JobName: "app-branches", Folder: "team"}
+
+	jClient := jenkins.ClientMock{}
+	jClient.On("DeleteJob", "team", "app-branches").Return(nil)
+
+	r, k8sClient := newTestReconcile(t, &jClient, instance)
+
+	res := testhelper.Reconcile(t, r, namespace, name)
+	require.Zero(t, res.RequeueAfter)
+	jClient.AssertExpectations(t)
+
+	require.Empty(t, testhelper.Get[jenkinsApi.JenkinsMultibranchPipeline](t, k8sClient, namespace, name).Finalizers)
+}
+
+func TestReconcile_Reconcile_NotFound(t *testing.T) {
+	r, _ := newTestReconcile(t, &jenkins.ClientMock{})
+
+	res := testhelper.Reconcile(t, r, namespace, name)
+	require.Equal(t, reconcile.Result{}, res)
+}
+
+func TestNewReconciler(t *testing.T) {
+	r := NewReconciler(fake.NewClientBuilder().Build(), &helper.LoggerMock{}, &pmock.PlatformService{})
+	require.NotNil(t, r.jenkinsClientFactory)
+	require.NotNil(t, r.now)
+}
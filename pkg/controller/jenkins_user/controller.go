@@ -0,0 +1,325 @@
+package jenkins_user
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/client/jenkins"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/helper"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/service/platform"
+)
+
+const (
+	finalizerName = "jenkinsuser.jenkins.finalizer.name"
+
+	// syncInterval is how often the user is applied again to pick up changes of the password Secret.
+	syncInterval = 10 * time.Minute
+
+	tokenName             = "operator"
+	tokenSecretSuffix     = "api-token"
+	tokenSecretUserKey    = "username"
+	tokenSecretTokenKey   = "token"
+	tokenSecretNameFormat = "%s-%s"
+)
+
+type Reconcile struct {
+	client               client.Client
+	log                  logr.Logger
+	jenkinsClientFactory jenkins.ClientFactory
+}
+
+func NewReconciler(k8sCl client.Client, logf logr.Logger, ps platform.PlatformService) *Reconcile {
+	return &Reconcile{
+		client:               k8sCl,
+		log:                  logf.WithName("controller_jenkins_user"),
+		jenkinsClientFactory: jenkins.MakeClientBuilder(ps, k8sCl),
+	}
+}
+
+func (r *Reconcile) SetupWithManager(mgr ctrl.Manager) error {
+	p := predicate.Funcs{
+		UpdateFunc: specUpdated,
+	}
+
+	if err := ctrl.NewControllerManagedBy(mgr).
+		For(&jenkinsApi.JenkinsUser{}, builder.WithPredicates(p)).
+		Complete(r); err != nil {
+		return fmt.Errorf("failed to create new managed controller: %w", err)
+	}
+
+	return nil
+}
+
+func specUpdated(e event.UpdateEvent) bool {
+	oldObject, ok := e.ObjectOld.(*jenkinsApi.JenkinsUser)
+	if !ok {
+		return false
+	}
+
+	newObject, ok := e.ObjectNew.(*jenkinsApi.JenkinsUser)
+	if !ok {
+		return false
+	}
+
+	return !reflect.DeepEqual(oldObject.Spec, newObject.Spec) ||
+		(oldObject.GetDeletionTimestamp().IsZero() && !newObject.GetDeletionTimestamp().IsZero())
+}
+
+func (r *Reconcile) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	reqLogger := r.log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+	reqLogger.Info("Reconciling JenkinsUser has been started")
+
+	instance := new(jenkinsApi.JenkinsUser)
+
+	if err := r.client.Get(ctx, request.NamespacedName, instance); err != nil {
+		if k8serrors.IsNotFound(err) {
+			reqLogger.Info("instance not found")
+
+			return reconcile.Result{}, nil
+		}
+
+		return reconcile.Result{}, fmt.Errorf("failed to get JenkinsUser instance: %w", err)
+	}
+
+	jc, err := r.jenkinsClientFactory.MakeNewClient(&instance.ObjectMeta, instance.Spec.OwnerName)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to create gojenkins client: %w", err)
+	}
+
+	if err := r.tryToReconcile(ctx, instance, jc); err != nil {
+		r.log.Error(err, "error during reconciliation", "instance", instance)
+		r.updateInstanceStatus(ctx, instance, err.Error())
+
+		return reconcile.Result{RequeueAfter: helper.DefaultRequeueTime * time.Second}, nil
+	}
+
+	r.updateInstanceStatus(ctx, instance, helper.StatusSuccess)
+
+	reqLogger.Info("Reconciling JenkinsUser has been finished")
+
+	if !instance.GetDeletionTimestamp().IsZero() {
+		return reconcile.Result{}, nil
+	}
+
+	return reconcile.Result{RequeueAfter: syncInterval}, nil
+}
+
+func (r *Reconcile) tryToReconcile(ctx context.Context, instance *jenkinsApi.JenkinsUser, jc jenkins.ClientInterface) error {
+	if instance.GetDeletionTimestamp().IsZero() {
+		if err := r.syncUser(ctx, instance, jc); err != nil {
+			return err
+		}
+
+		if err := r.syncAPIToken(ctx, instance, jc); err != nil {
+			return err
+		}
+	}
+
+	updateNeeded, err := helper.TryToDelete(instance, finalizerName, makeDeletionFunc(instance, jc))
+	if err != nil {
+		return fmt.Errorf("failed to delete instance: %w", err)
+	}
+
+	if updateNeeded {
+		return helper.UpdateKeepingStatus(ctx, r.client, instance, &instance.Status)
+	}
+
+	return nil
+}
+
+// syncUser creates the user in Jenkins or updates its password and details.
+func (r *Reconcile) syncUser(ctx context.Context, instance *jenkinsApi.JenkinsUser, jc jenkins.ClientInterface) error {
+	spec := instance.Spec
+
+	password, err := r.getSecretKey(ctx, instance.Namespace, &spec.PasswordRef)
+	if err != nil {
+		return fmt.Errorf("failed to get user password: %w", err)
+	}
+
+	if isUserRenamed(instance) {
+		if err = jc.DeleteUser(instance.Status.UserName); err != nil {
+			return fmt.Errorf("failed to delete old user %s: %w", instance.Status.UserName, err)
+		}
+
+		// the token of the old user is gone with it.
+		instance.Status.TokenUUID = ""
+	}
+
+	if err = jc.SaveUser(spec.UserName, password, spec.FullName, spec.Email); err != nil {
+		return fmt.Errorf("failed to save user: %w", err)
+	}
+
+	instance.Status.UserName = spec.UserName
+
+	return nil
+}
+
+// syncAPIToken issues the API token into the Secret when it is requested and revokes it otherwise.
+func (r *Reconcile) syncAPIToken(ctx context.Context, instance *jenkinsApi.JenkinsUser, jc jenkins.ClientInterface) error {
+	if instance.Spec.APIToken == nil {
+		return r.revokeAPIToken(ctx, instance, jc)
+	}
+
+	secretName := tokenSecretName(instance)
+
+	if instance.Status.TokenSecretName != "" && instance.Status.TokenSecretName != secretName {
+		if err := r.revokeAPIToken(ctx, instance, jc); err != nil {
+			return err
+		}
+	}
+
+	secret := &corev1.Secret{}
+
+	err := r.client.Get(ctx, types.NamespacedName{Namespace: instance.Namespace, Name: secretName}, secret)
+	if err == nil && instance.Status.TokenUUID != "" {
+		return nil
+	}
+
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get secret %s: %w", secretName, err)
+	}
+
+	if instance.Status.TokenUUID != "" {
+		// the Secret is lost, the token can not be recovered so it is replaced with a new one.
+		if err = jc.RevokeAPIToken(instance.Spec.UserName, instance.Status.TokenUUID); err != nil {
+			return fmt.Errorf("failed to revoke lost API token: %w", err)
+		}
+	}
+
+	token, err := jc.CreateAPIToken(instance.Spec.UserName, tokenName)
+	if err != nil {
+		return fmt.Errorf("failed to create API token: %w", err)
+	}
+
+	if err = r.saveTokenSecret(ctx, instance, secretName, token); err != nil {
+		return err
+	}
+
+	instance.Status.TokenUUID = token.UUID
+	instance.Status.TokenSecretName = secretName
+
+	return nil
+}
+
+func (r *Reconcile) saveTokenSecret(
+	ctx context.Context,
+	instance *jenkinsApi.JenkinsUser,
+	secretName string,
+	token *jenkins.APIToken,
+) error {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      secretName,
+			Namespace: instance.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(instance, jenkinsApi.SchemeGroupVersion.WithKind("JenkinsUser")),
+			},
+		},
+		Data: map[string][]byte{
+			tokenSecretUserKey:  []byte(instance.Spec.UserName),
+			tokenSecretTokenKey: []byte(token.Value),
+		},
+	}
+
+	err := r.client.Create(ctx, secret)
+	if k8serrors.IsAlreadyExists(err) {
+		err = r.client.Update(ctx, secret)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to save secret %s: %w", secretName, err)
+	}
+
+	return nil
+}
+
+func (r *Reconcile) revokeAPIToken(ctx context.Context, instance *jenkinsApi.JenkinsUser, jc jenkins.ClientInterface) error {
+	if instance.Status.TokenUUID != "" {
+		if err := jc.RevokeAPIToken(instance.Spec.UserName, instance.Status.TokenUUID); err != nil {
+			return fmt.Errorf("failed to revoke API token: %w", err)
+		}
+	}
+
+	if instance.Status.TokenSecretName != "" {
+		secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+			Name:      instance.Status.TokenSecretName,
+			Namespace: instance.Namespace,
+		}}
+
+		if err := r.client.Delete(ctx, secret); err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete secret %s: %w", secret.Name, err)
+		}
+	}
+
+	instance.Status.TokenUUID = ""
+	instance.Status.TokenSecretName = ""
+
+	return nil
+}
+
+func (r *Reconcile) getSecretKey(ctx context.Context, namespace string, selector *corev1.SecretKeySelector) (string, error) {
+	secret := &corev1.Secret{}
+
+	if err := r.client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: selector.Name}, secret); err != nil {
+		return "", fmt.Errorf("failed to get secret %s: %w", selector.Name, err)
+	}
+
+	value, ok := secret.Data[selector.Key]
+	if !ok {
+		return "", fmt.Errorf("secret %s has no key %s", selector.Name, selector.Key)
+	}
+
+	return string(value), nil
+}
+
+func tokenSecretName(instance *jenkinsApi.JenkinsUser) string {
+	if instance.Spec.APIToken.SecretName != "" {
+		return instance.Spec.APIToken.SecretName
+	}
+
+	return fmt.Sprintf(tokenSecretNameFormat, instance.Name, tokenSecretSuffix)
+}
+
+func isUserRenamed(instance *jenkinsApi.JenkinsUser) bool {
+	return instance.Status.UserName != "" && instance.Status.UserName != instance.Spec.UserName
+}
+
+func makeDeletionFunc(instance *jenkinsApi.JenkinsUser, jc jenkins.ClientInterface) func() error {
+	return func() error {
+		// API tokens are deleted with the user, the token Secret is garbage collected with the instance.
+		if err := jc.DeleteUser(instance.Spec.UserName); err != nil {
+			return fmt.Errorf("failed to delete user: %w", err)
+		}
+
+		if isUserRenamed(instance) {
+			if err := jc.DeleteUser(instance.Status.UserName); err != nil {
+				return fmt.Errorf("failed to delete old user %s: %w", instance.Status.UserName, err)
+			}
+		}
+
+		return nil
+	}
+}
+
+func (r *Reconcile) updateInstanceStatus(ctx context.Context, instance *jenkinsApi.JenkinsUser, statusValue string) {
+	instance.Status.Value = statusValue
+
+	if err := r.client.Status().Update(ctx, instance); err != nil {
+		r.log.Error(err, "unable to update status", "instance", instance)
+	}
+}
@@ -0,0 +1,164 @@
+package jenkins_user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/client/jenkins"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/helper"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/helper/testhelper"
+)
+
+const (
+	name      = "developer"
+	namespace = "ns"
+)
+
+func getTestJenkinsUser() *jenkinsApi.JenkinsUser {
+	return &jenkinsApi.JenkinsUser{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: jenkinsApi.JenkinsUserSpec{
+			UserName: "dev",
+			PasswordRef: corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "dev-password"},
+				Key:                  "password",
+			},
+			FullName: "Developer",
+			APIToken: &jenkinsApi.JenkinsUserAPIToken{},
+		},
+	}
+}
+
+func newTestReconcile(t *testing.T, jClient *jenkins.ClientMock, objects ...client.Object) (*Reconcile, client.Client) {
+	t.Helper()
+
+	password := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "dev-password", Namespace: namespace},
+		Data:       map[string][]byte{"password": []byte("pwd")},
+	}
+
+	k8sClient := testhelper.NewFakeClient(t, append(objects, password)...)
+
+	return &Reconcile{
+		client:               k8sClient,
+		jenkinsClientFactory: testhelper.NewClientFactory(jClient),
+		log:                  &helper.LoggerMock{},
+	}, k8sClient
+}
+
+func TestReconcile_Reconcile(t *testing.T) {
+	instance := getTestJenkinsUser()
+
+	jClient := jenkins.ClientMock{}
+	jClient.On("SaveUser", "dev", "pwd", "Developer", "").Return(nil)
+	jClient.On("CreateAPIToken", "dev", tokenName).
+		Return(&jenkins.APIToken{UUID: "uuid", Name: tokenName, Value: "token"}, nil)
+
+	r, k8sClient := newTestReconcile(t, &jClient, instance)
+
+	res := testhelper.Reconcile(t, r, namespace, name)
+	require.Equal(t, syncInterval, res.RequeueAfter)
+	jClient.AssertExpectations(t)
+
+	checkInstance := testhelper.Get[jenkinsApi.JenkinsUser](t, k8sClient, namespace, name)
+	require.Equal(t, helper.StatusSuccess, checkInstance.Status.Value)
+	require.Equal(t, "dev", checkInstance.Status.UserName)
+	require.Equal(t, "uuid", checkInstance.Status.TokenUUID)
+	require.Equal(t, "developer-api-token", checkInstance.Status.TokenSecretName)
+
+	var secret corev1.Secret
+	require.NoError(t, k8sClient.Get(context.Background(),
+		types.NamespacedName{Namespace: namespace, Name: "developer-api-token"}, &secret))
+	require.Equal(t, "dev", string(secret.Data[tokenSecretUserKey]))
+	require.Equal(t, "token", string(secret.Data[tokenSecretTokenKey]))
+	require.Equal(t, name, secret.OwnerReferences[0].Name)
+
+	// the token is not issued again while its Secret exists.
+	testhelper.Reconcile(t, r, namespace, name)
+	jClient.AssertNumberOfCalls(t, "CreateAPIToken", 1)
+}
+
+func TestReconcile_Reconcile_RevokeToken(t *testing.T) {
+	instance := getTestJenkinsUser()
+	instance.Spec.APIToken = nil
+	instance.Status = jenkinsApi.JenkinsUserStatus{
+		UserName:        "dev",
+		TokenUUID:       "uuid",
+		TokenSecretName: "developer-api-token",
+	}
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "developer-api-token", Namespace: namespace}}
+
+	jClient := jenkins.ClientMock{}
+	jClient.On("SaveUser", "dev", "pwd", "Developer", "").Return(nil)
+	jClient.On("RevokeAPIToken", "dev", "uuid").Return(nil)
+
+	r, k8sClient := newTestReconcile(t, &jClient, instance, secret)
+
+	testhelper.Reconcile(t, r, namespace, name)
+	jClient.AssertExpectations(t)
+
+	checkInstance := testhelper.Get[jenkinsApi.JenkinsUser](t, k8sClient, namespace, name)
+	require.Equal(t, helper.StatusSuccess, checkInstance.Status.Value)
+	require.Empty(t, checkInstance.Status.TokenUUID)
+
+	err := k8sClient.Get(context.Background(),
+		types.NamespacedName{Namespace: namespace, Name: "developer-api-token"}, &corev1.Secret{})
+	require.True(t, k8serrors.IsNotFound(err))
+}
+
+func TestReconcile_Reconcile_Rename(t *testing.T) {
+	instance := getTestJenkinsUser()
+	instance.Spec.APIToken = nil
+	instance.Status.UserName = "old"
+
+	jClient := jenkins.ClientMock{}
+	jClient.On("DeleteUser", "old").Return(nil)
+	jClient.On("SaveUser", "dev", "pwd", "Developer", "").Return(nil)
+
+	r, k8sClient := newTestReconcile(t, &jClient, instance)
+
+	testhelper.Reconcile(t, r, namespace, name)
+	jClient.AssertExpectations(t)
+	require.Equal(t, "dev", testhelper.Get[jenkinsApi.JenkinsUser](t, k8sClient, namespace, name).Status.UserName)
+}
+
+func TestReconcile_Reconcile_Delete(t *testing.T) {
+	instance := getTestJenkinsUser()
+	instance.Finalizers = []string{finalizerName}
+	instance.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+
+	jClient := jenkins.ClientMock{}
+	jClient.On("DeleteUser", "dev").Return(nil)
+
+	r, k8sClient := newTestReconcile(t, &jClient, instance)
+
+	res := testhelper.Reconcile(t, r, namespace, name)
+	require.Zero(t, res.RequeueAfter)
+	jClient.AssertExpectations(t)
+
+	require.Empty(t, testhelper.Get[jenkinsApi.JenkinsUser](t, k8sClient, namespace, name).Finalizers)
+}
+
+func TestReconcile_Reconcile_PasswordSecretMissing(t *testing.T) {
+	instance := getTestJenkinsUser()
+	instance.Spec.PasswordRef.Name = "missing"
+
+	jClient := jenkins.ClientMock{}
+	r, k8sClient := newTestReconcile(t, &jClient, instance)
+
+	res := testhelper.Reconcile(t, r, namespace, name)
+	require.Equal(t, helper.DefaultRequeueTime, int(res.RequeueAfter.Seconds()))
+
+	got := testhelper.Get[jenkinsApi.JenkinsUser](t, k8sClient, namespace, name)
+	require.Contains(t, got.Status.Value, "failed to get user password")
+}
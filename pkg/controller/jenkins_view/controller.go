@@ -0,0 +1,227 @@
+package jenkins_view
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/go-logr/logr"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/client/jenkins"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/helper"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/service/platform"
+)
+
+const (
+	finalizerName = "jenkinsview.jenkins.finalizer.name"
+
+	// syncInterval is how often the view is saved again to revert the changes made in Jenkins.
+	syncInterval = 10 * time.Minute
+)
+
+// columnClasses are the classes of the view columns.
+var columnClasses = map[jenkinsApi.ViewColumn]string{
+	jenkinsApi.ViewColumnStatus:       "hudson.views.StatusColumn",
+	jenkinsApi.ViewColumnWeather:      "hudson.views.WeatherColumn",
+	jenkinsApi.ViewColumnJobName:      "hudson.views.JobColumn",
+	jenkinsApi.ViewColumnLastSuccess:  "hudson.views.LastSuccessColumn",
+	jenkinsApi.ViewColumnLastFailure:  "hudson.views.LastFailureColumn",
+	jenkinsApi.ViewColumnLastStable:   "hudson.views.LastStableColumn",
+	jenkinsApi.ViewColumnLastDuration: "hudson.views.LastDurationColumn",
+	jenkinsApi.ViewColumnBuildButton:  "hudson.views.BuildButtonColumn",
+}
+
+// defaultColumns are the columns Jenkins adds to a new list view.
+var defaultColumns = []jenkinsApi.ViewColumn{
+	jenkinsApi.ViewColumnStatus,
+	jenkinsApi.ViewColumnWeather,
+	jenkinsApi.ViewColumnJobName,
+	jenkinsApi.ViewColumnLastSuccess,
+	jenkinsApi.ViewColumnLastFailure,
+	jenkinsApi.ViewColumnLastDuration,
+	jenkinsApi.ViewColumnBuildButton,
+}
+
+type Reconcile struct {
+	client               client.Client
+	log                  logr.Logger
+	jenkinsClientFactory jenkins.ClientFactory
+}
+
+func NewReconciler(k8sCl client.Client, logf logr.Logger, ps platform.PlatformService) *Reconcile {
+	return &Reconcile{
+		client:               k8sCl,
+		log:                  logf.WithName("controller_jenkins_view"),
+		jenkinsClientFactory: jenkins.MakeClientBuilder(ps, k8sCl),
+	}
+}
+
+func (r *Reconcile) SetupWithManager(mgr ctrl.Manager) error {
+	p := predicate.Funcs{
+		UpdateFunc: specUpdated,
+	}
+
+	if err := ctrl.NewControllerManagedBy(mgr).
+		For(&jenkinsApi.JenkinsView{}, builder.WithPredicates(p)).
+		Complete(r); err != nil {
+		return fmt.Errorf("failed to create new managed controller: %w", err)
+	}
+
+	return nil
+}
+
+func specUpdated(e event.UpdateEvent) bool {
+	oldObject, ok := e.ObjectOld.(*jenkinsApi.JenkinsView)
+	if !ok {
+		return false
+	}
+
+	newObject, ok := e.ObjectNew.(*jenkinsApi.JenkinsView)
+	if !ok {
+		return false
+	}
+
+	return !reflect.DeepEqual(oldObject.Spec, newObject.Spec) ||
+		(oldObject.GetDeletionTimestamp().IsZero() && !newObject.GetDeletionTimestamp().IsZero())
+}
+
+func (r *Reconcile) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	reqLogger := r.log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+	reqLogger.Info("Reconciling JenkinsView has been started")
+
+	instance := new(jenkinsApi.JenkinsView)
+
+	if err := r.client.Get(ctx, request.NamespacedName, instance); err != nil {
+		if k8serrors.IsNotFound(err) {
+			reqLogger.Info("instance not found")
+
+			return reconcile.Result{}, nil
+		}
+
+		return reconcile.Result{}, fmt.Errorf("failed to get JenkinsView instance: %w", err)
+	}
+
+	if err := r.tryToReconcile(ctx, instance); err != nil {
+		r.log.Error(err, "error during reconciliation", "instance", instance)
+		r.updateInstanceStatus(ctx, instance, err.Error())
+
+		return reconcile.Result{RequeueAfter: helper.DefaultRequeueTime * time.Second}, nil
+	}
+
+	r.updateInstanceStatus(ctx, instance, helper.StatusSuccess)
+
+	reqLogger.Info("Reconciling JenkinsView has been finished")
+
+	if !instance.GetDeletionTimestamp().IsZero() {
+		return reconcile.Result{}, nil
+	}
+
+	return reconcile.Result{RequeueAfter: syncInterval}, nil
+}
+
+func (r *Reconcile) tryToReconcile(ctx context.Context, instance *jenkinsApi.JenkinsView) error {
+	jc, err := r.jenkinsClientFactory.MakeNewClient(&instance.ObjectMeta, instance.Spec.OwnerName)
+	if err != nil {
+		return fmt.Errorf("failed to create gojenkins client: %w", err)
+	}
+
+	refs := append([]metav1.OwnerReference(nil), instance.GetOwnerReferences()...)
+
+	if instance.GetDeletionTimestamp().IsZero() {
+		if err = r.syncView(instance, jc); err != nil {
+			return err
+		}
+	}
+
+	updateNeeded, err := helper.TryToDelete(instance, finalizerName, makeDeletionFunc(instance, jc))
+	if err != nil {
+		return fmt.Errorf("failed to delete instance: %w", err)
+	}
+
+	if updateNeeded || !reflect.DeepEqual(refs, instance.GetOwnerReferences()) {
+		return helper.UpdateKeepingStatus(ctx, r.client, instance, &instance.Status)
+	}
+
+	return nil
+}
+
+// syncView saves the view in its folder and removes the view saved before under another name or in another folder.
+func (r *Reconcile) syncView(instance *jenkinsApi.JenkinsView, jc jenkins.ClientInterface) error {
+	folder, err := helper.SetFolderOrJenkinsOwner(r.client, instance, instance.Spec.FolderName, instance.Spec.OwnerName)
+	if err != nil {
+		return err
+	}
+
+	status := instance.Status
+
+	if status.ViewName != "" && (status.ViewName != instance.Spec.Name || status.Folder != folder) {
+		if err = jc.DeleteListView(status.Folder, status.ViewName); err != nil {
+			return fmt.Errorf("failed to delete old view %s: %w", status.ViewName, err)
+		}
+
+		instance.Status.ViewName = ""
+		instance.Status.Folder = ""
+	}
+
+	if err = jc.SaveListView(folder, listView(instance)); err != nil {
+		return fmt.Errorf("failed to save view: %w", err)
+	}
+
+	instance.Status.ViewName = instance.Spec.Name
+	instance.Status.Folder = folder
+
+	return nil
+}
+
+func listView(instance *jenkinsApi.JenkinsView) *jenkins.ListView {
+	columns := instance.Spec.Columns
+	if len(columns) == 0 {
+		columns = defaultColumns
+	}
+
+	classes := make([]string, 0, len(columns))
+	for _, c := range columns {
+		classes = append(classes, columnClasses[c])
+	}
+
+	return &jenkins.ListView{
+		Name:         instance.Spec.Name,
+		Description:  instance.Spec.Description,
+		Jobs:         instance.Spec.Jobs,
+		IncludeRegex: instance.Spec.IncludeRegex,
+		Recurse:      instance.Spec.Recurse,
+		Columns:      classes,
+	}
+}
+
+func makeDeletionFunc(instance *jenkinsApi.JenkinsView, jc jenkins.ClientInterface) func() error {
+	return func() error {
+		if instance.Status.ViewName == "" {
+			return nil
+		}
+
+		if err := jc.DeleteListView(instance.Status.Folder, instance.Status.ViewName); err != nil {
+			return fmt.Errorf("failed to delete view: %w", err)
+		}
+
+		return nil
+	}
+}
+
+func (r *Reconcile) updateInstanceStatus(ctx context.Context, instance *jenkinsApi.JenkinsView, statusValue string) {
+	instance.Status.Value = statusValue
+
+	if err := r.client.Status().Update(ctx, instance); err != nil {
+		r.log.Error(err, "unable to update status", "instance", instance)
+	}
+}
@@ -0,0 +1,227 @@
+package jenkins_view
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	pmock "github.com/epam/edp-jenkins-operator/v2/mock/platform"
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/client/jenkins"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/helper"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/helper/testhelper"
+)
+
+const (
+	name      = "release"
+	namespace = "ns"
+)
+
+func getTestJenkinsView() *jenkinsApi.JenkinsView {
+	return &jenkinsApi.JenkinsView{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: jenkinsApi.JenkinsViewSpec{
+			Name:         "Release",
+			IncludeRegex: "release-.*",
+			Columns:      []jenkinsApi.ViewColumn{jenkinsApi.ViewColumnStatus, jenkinsApi.ViewColumnJobName},
+		},
+	}
+}
+
+func getTestListView() *jenkins.ListView {
+	return &jenkins.ListView{
+		Name:         "Release",
+		IncludeRegex: "release-.*",
+		Columns:      []string{"hudson.views.StatusColumn", "hudson.views.JobColumn"},
+	}
+}
+
+func newTestReconcile(t *testing.T, jClient *jenkins.ClientMock, objects ...client.Object) (*Reconcile, client.Client) {
+	t.Helper()
+
+	jenkinsInstance := &jenkinsApi.Jenkins{
+		ObjectMeta: metav1.ObjectMeta{Name: "jenkins", Namespace: namespace},
+	}
+
+	k8sClient := testhelper.NewFakeClient(t, append(objects, jenkinsInstance)...)
+
+	return &Reconcile{
+		client:               k8sClient,
+		jenkinsClientFactory: testhelper.NewClientFactory(jClient),
+		log:                  &helper.LoggerMock{},
+	}, k8sClient
+}
+
+func TestReconcile_Reconcile(t *testing.T) {
+	instance := getTestJenkinsView()
+
+	jClient := jenkins.ClientMock{}
+	jClient.On("SaveListView", "", getTestListView()).Return(nil)
+
+	r, k8sClient := newTestReconcile(t, &jClient, instance)
+
+	res := testhelper.Reconcile(t, r, namespace, name)
+	require.Equal(t, syncInterval, res.RequeueAfter)
+	jClient.AssertExpectations(t)
+
+	got := testhelper.Get[jenkinsApi.JenkinsView](t, k8sClient, namespace, name)
+	require.Equal(t, helper.StatusSuccess, got.Status.Value)
+	require.Equal(t, "Release", got.Status.ViewName)
+	require.Empty(t, got.Status.Folder)
+	require.Equal(t, []string{finalizerName}, got.Finalizers)
+	require.Len(t, got.OwnerReferences, 1)
+	require.Equal(t, "Jenkins", got.OwnerReferences[0].Kind)
+	require.Equal(t, "jenkins", got.OwnerReferences[0].Name)
+}
+
+func TestReconcile_Reconcile_Folder(t *testing.T) {
+	instance := getTestJenkinsView()
+	instance.Spec.FolderName = strPtr("team-codebase")
+	instance.Spec.Columns = nil
+	instance.Spec.Jobs = []string{"deploy"}
+
+	folder := &jenkinsApi.JenkinsFolder{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-codebase", Namespace: namespace},
+		Spec:       jenkinsApi.JenkinsFolderSpec{Job: &jenkinsApi.Job{}},
+		Status:     jenkinsApi.JenkinsFolderStatus{Available: true},
+	}
+
+	view := getTestListView()
+	view.Jobs = []string{"deploy"}
+	view.Columns = []string{
+		"hudson.views.StatusColumn",
+		"hudson.views.WeatherColumn",
+		"hudson.views.JobColumn",
+		"hudson.views.LastSuccessColumn",
+		"hudson.views.LastFailureColumn",
+		"hudson.views.LastDurationColumn",
+		"hudson.views.BuildButtonColumn",
+	}
+
+	jClient := jenkins.ClientMock{}
+	jClient.On("SaveListView", "team", view).Return(nil)
+
+	r, k8sClient := newTestReconcile(t, &jClient, instance, folder)
+
+	testhelper.Reconcile(t, r, namespace, name)
+	jClient.AssertExpectations(t)
+
+	got := testhelper.Get[jenkinsApi.JenkinsView](t, k8sClient, namespace, name)
+	require.Equal(t, helper.StatusSuccess, got.Status.Value)
+	require.Equal(t, "team", got.Status.Folder)
+	require.Len(t, got.OwnerReferences, 1)
+	require.Equal(t, "JenkinsFolder", got.OwnerReferences[0].Kind)
+}
+
+func TestReconcile_Reconcile_FolderNotAvailable(t *testing.T) {
+	instance := getTestJenkinsView()
+	instance.Spec.FolderName = strPtr("team")
+
+	folder := &jenkinsApi.JenkinsFolder{
+		ObjectMeta: metav1.ObjectMeta{Name: "team", Namespace: namespace},
+	}
+
+	r, k8sClient := newTestReconcile(t, &jenkins.ClientMock{}, instance, folder)
+
+	res := testhelper.Reconcile(t, r, namespace, name)
+	require.Equal(t, helper.DefaultRequeueTime, int(res.RequeueAfter.Seconds()))
+
+	got := testhelper.Get[jenkinsApi.JenkinsView](t, k8sClient, namespace, name)
+	require.Equal(t, "jenkins folder team is not available yet", got.Status.Value)
+}
+
+func TestReconcile_Reconcile_MovedToRoot(t *testing.T) {
+	instance := getTestJenkinsView()
+	instance.Finalizers = []string{finalizerName}
+	instance.Status = jenkinsApi.JenkinsViewStatus{ViewName: "Release", Folder: "team"}
+	instance.OwnerReferences = []metav1.OwnerReference{
+		{APIVersion: "v2.edp.epam.com/v1", Kind: "JenkinsFolder", Name: "team"},
+	}
+
+	jClient := jenkins.ClientMock{}
+	jClient.On("DeleteListView", "team", "Release").Return(nil)
+	jClient.On("SaveListView", "", getTestListView()).Return(nil)
+
+	r, k8sClient := newTestReconcile(t, &jClient, instance)
+
+	testhelper.Reconcile(t, r, namespace, name)
+	jClient.AssertExpectations(t)
+
+	got := testhelper.Get[jenkinsApi.JenkinsView](t, k8sClient, namespace, name)
+	require.Equal(t, helper.StatusSuccess, got.Status.Value)
+	require.Empty(t, got.Status.Folder)
+	require.Len(t, got.OwnerReferences, 1)
+	require.Equal(t, "Jenkins", got.OwnerReferences[0].Kind)
+}
+
+func TestReconcile_Reconcile_Renamed(t *testing.T) {
+	instance := getTestJenkinsView()
+	instance.Finalizers = []string{finalizerName}
+	instance.Status = jenkinsApi.JenkinsViewStatus{ViewName: "Old", Folder: "team"}
+
+	jClient := jenkins.ClientMock{}
+	jClient.On("DeleteListView", "team", "Old").Return(nil)
+	jClient.On("SaveListView", "", getTestListView()).Return(nil)
+
+	r, k8sClient := newTestReconcile(t, &jClient, instance)
+
+	testhelper.Reconcile(t, r, namespace, name)
+	jClient.AssertExpectations(t)
+
+	got := testhelper.Get[jenkinsApi.JenkinsView](t, k8sClient, namespace, name)
+	require.Equal(t, "Release", got.Status.ViewName)
+	require.Empty(t, got.Status.Folder)
+}
+
+func TestReconcile_Reconcile_SaveErr(t *testing.T) {
+	instance := getTestJenkinsView()
+
+	jClient := jenkins.ClientMock{}
+	jClient.On("SaveListView", "", getTestListView()).Return(errors.New("save fatal"))
+
+	r, k8sClient := newTestReconcile(t, &jClient, instance)
+
+	res := testhelper.Reconcile(t, r, namespace, name)
+	require.Equal(t, helper.DefaultRequeueTime, int(res.RequeueAfter.Seconds()))
+	require.Equal(t, "failed to save view: save fatal", testhelper.Get[jenkinsApi.JenkinsView](t, k8sClient, namespace, name).Status.Value)
+}
+
+func TestReconcile_Reconcile_Delete(t *testing.T) {
+	instance := getTestJenkinsView()
+	instance.Finalizers = []string{finalizerName}
+	instance.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	instance.Status = jenkinsApi.JenkinsViewStatus{ViewName: "Release", Folder: "team"}
+
+	jClient := jenkins.ClientMock{}
+	jClient.On("DeleteListView", "team", "Release").Return(nil)
+
+	r, k8sClient := newTestReconcile(t, &jClient, instance)
+
+	res := testhelper.Reconcile(t, r, namespace, name)
+	require.Zero(t, res.RequeueAfter)
+	jClient.AssertExpectations(t)
+
+	require.Empty(t, testhelper.Get[jenkinsApi.JenkinsView](t, k8sClient, namespace, name).Finalizers)
+}
+
+func TestReconcile_Reconcile_NotFound(t *testing.T) {
+	r, _ := newTestReconcile(t, &jenkins.ClientMock{})
+
+	res := testhelper.Reconcile(t, r, namespace, name)
+	require.Equal(t, reconcile.Result{}, res)
+}
+
+func TestNewReconciler(t *testing.T) {
+	r := NewReconciler(fake.NewClientBuilder().Build(), &helper.LoggerMock{}, &pmock.PlatformService{})
+	require.NotNil(t, r.jenkinsClientFactory)
+}
+
+func strPtr(s string) *string {
+	return &s
+}
@@ -2,13 +2,17 @@ package jenkinsagent
 
 import (
 	"context"
+	"encoding/xml"
+	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
 	v1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -18,21 +22,32 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+	jenkinsClient "github.com/epam/edp-jenkins-operator/v2/pkg/client/jenkins"
 	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/helper"
 	"github.com/epam/edp-jenkins-operator/v2/pkg/service/jenkins"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/service/platform"
 )
 
-const finalizerName = "jenkinsagent.jenkins.finalizer.name"
+const (
+	finalizerName = "jenkinsagent.jenkins.finalizer.name"
+
+	// registrationCheckInterval is how often the registration of the template in Jenkins is checked again.
+	registrationCheckInterval = 10 * time.Minute
+)
 
 type Reconcile struct {
-	client client.Client
-	log    logr.Logger
+	client               client.Client
+	log                  logr.Logger
+	jenkinsClientFactory jenkinsClient.ClientFactory
+	now                  func() time.Time
 }
 
-func NewReconciler(k8sCl client.Client, logf logr.Logger) *Reconcile {
+func NewReconciler(k8sCl client.Client, logf logr.Logger, ps platform.PlatformService) *Reconcile {
 	return &Reconcile{
-		client: k8sCl,
-		log:    logf.WithName("controller_jenkins_authorizationrole"),
+		client:               k8sCl,
+		log:                  logf.WithName("controller_jenkins_agent"),
+		jenkinsClientFactory: jenkinsClient.MakeClientBuilder(ps, k8sCl),
+		now:                  time.Now,
 	}
 }
 
@@ -98,13 +113,119 @@ func (r *Reconcile) Reconcile(ctx context.Context, request reconcile.Request) (r
 
 	reqLogger.V(2).Info("Reconciling JenkinsAgent has been finished")
 
-	return reconcile.Result{}, nil
+	if !instance.GetDeletionTimestamp().IsZero() {
+		return reconcile.Result{}, nil
+	}
+
+	return reconcile.Result{RequeueAfter: registrationCheckInterval}, nil
 }
 
 func (r *Reconcile) tryToReconcile(ctx context.Context, instance *jenkinsApi.JenkinsAgent) error {
+	if instance.GetDeletionTimestamp().IsZero() {
+		if err := r.saveTemplate(ctx, instance); err != nil {
+			return err
+		}
+	}
+
+	updateNeeded, err := helper.TryToDelete(instance, finalizerName, makeDeletionFunc(ctx, r.client, instance))
+	if err != nil {
+		return fmt.Errorf("failed to delete jenkins agent: %w", err)
+	}
+
+	if updateNeeded {
+		if err = r.client.Update(ctx, instance); err != nil {
+			return fmt.Errorf("failed to update instance: %w", err)
+		}
+	}
+
+	if !instance.GetDeletionTimestamp().IsZero() {
+		return nil
+	}
+
+	return r.checkRegistration(instance)
+}
+
+// checkRegistration verifies the Kubernetes plugin has loaded the template, so its label can be used by the builds.
+func (r *Reconcile) checkRegistration(instance *jenkinsApi.JenkinsAgent) error {
+	jc, err := r.jenkinsClientFactory.MakeNewClient(&instance.ObjectMeta, instance.Spec.OwnerName)
+	if err != nil {
+		return fmt.Errorf("failed to create gojenkins client: %w", err)
+	}
+
+	templates, err := jc.GetPodTemplates()
+	if err != nil {
+		return fmt.Errorf("failed to get pod templates: %w", err)
+	}
+
+	label := templateLabel(&instance.Spec)
+	instance.Status.Registered = isLabelRegistered(templates, label)
+
+	if !instance.Status.Registered {
+		return fmt.Errorf("template label %q is not registered in the Jenkins kubernetes clouds", label)
+	}
+
+	instance.Status.LastSeen = &metav1.Time{Time: r.now()}
+
+	return nil
+}
+
+// templateLabel returns the label of the structured or raw template, the agent name is used if it is not set.
+func templateLabel(spec *jenkinsApi.JenkinsAgentSpec) string {
+	var label string
+
+	if spec.PodTemplate != nil {
+		label = spec.PodTemplate.Label
+	} else {
+		var pt podTemplateXML
+
+		if err := xml.Unmarshal([]byte(spec.Template), &pt); err == nil {
+			label = pt.Label
+		}
+	}
+
+	if strings.TrimSpace(label) == "" {
+		return spec.Name
+	}
+
+	return label
+}
+
+// isLabelRegistered checks whether a template has all labels of the given label.
+func isLabelRegistered(templates []jenkinsClient.PodTemplate, label string) bool {
+	for _, t := range templates {
+		registered := make(map[string]bool)
+		for _, l := range strings.Fields(t.Label) {
+			registered[l] = true
+		}
+
+		found := true
+
+		for _, l := range strings.Fields(label) {
+			if !registered[l] {
+				found = false
+
+				break
+			}
+		}
+
+		if found {
+			return true
+		}
+	}
+
+	return false
+}
+
+// saveTemplate puts the agent template into the slaves ConfigMap the Kubernetes plugin loads the templates from.
+func (r *Reconcile) saveTemplate(ctx context.Context, instance *jenkinsApi.JenkinsAgent) error {
+	template, err := agentTemplate(instance)
+	if err != nil {
+		return err
+	}
+
 	var slavesCm v1.ConfigMap
 
-	if err := r.client.Get(
+	if err = r.client.Get(
 		ctx,
 		types.NamespacedName{
 			Namespace: instance.Namespace,
@@ -115,26 +236,40 @@ func (r *Reconcile) tryToReconcile(ctx context.Context, instance *jenkinsApi.Jen
 		return fmt.Errorf("failed to get slaves config map: %w", err)
 	}
 
-	slavesCm.Data[instance.Spec.SalvesKey()] = instance.Spec.Template
+	if slavesCm.Data == nil {
+		slavesCm.Data = make(map[string]string)
+	}
+
+	slavesCm.Data[instance.Spec.SalvesKey()] = template
 
-	if err := r.client.Update(ctx, &slavesCm); err != nil {
+	if err = r.client.Update(ctx, &slavesCm); err != nil {
 		return fmt.Errorf("failed to update slaves config map: %w", err)
 	}
 
-	updateNeeded, err := helper.TryToDelete(instance, finalizerName, makeDeletionFunc(ctx, r.client, instance))
-	if err != nil {
-		return fmt.Errorf("failed to delete jenkins agent: %w", err)
+	return nil
+}
+
+// agentTemplate returns the raw template or the one generated from the structured pod template.
+// The problems of the structured pod template are reported in the instance status.
+func agentTemplate(instance *jenkinsApi.JenkinsAgent) (string, error) {
+	spec := &instance.Spec
+	instance.Status.Errors = nil
+
+	if (spec.Template == "") == (spec.PodTemplate == nil) {
+		return "", errors.New("exactly one of template and podTemplate must be set")
 	}
 
-	if !updateNeeded {
-		return nil
+	if spec.PodTemplate == nil {
+		return spec.Template, nil
 	}
 
-	if err := r.client.Update(ctx, instance); err != nil {
-		return fmt.Errorf("failed to update instance: %w", err)
+	if errs := validatePodTemplate(spec.PodTemplate); len(errs) > 0 {
+		instance.Status.Errors = errs
+
+		return "", fmt.Errorf("pod template is invalid: %s", strings.Join(errs, "; "))
 	}
 
-	return nil
+	return renderPodTemplate(spec)
 }
 
 func makeDeletionFunc(ctx context.Context, k8sClient client.Client, instance *jenkinsApi.JenkinsAgent) func() error {
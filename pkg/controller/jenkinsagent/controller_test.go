@@ -15,11 +15,23 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	pmock "github.com/epam/edp-jenkins-operator/v2/mock/platform"
 	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+	jenkinsClient "github.com/epam/edp-jenkins-operator/v2/pkg/client/jenkins"
 	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/helper"
 	"github.com/epam/edp-jenkins-operator/v2/pkg/service/jenkins"
 )
 
+func newTestClientFactory(templates ...jenkinsClient.PodTemplate) *jenkinsClient.ClientBuilderMock {
+	jc := jenkinsClient.ClientMock{}
+	jc.On("GetPodTemplates").Return(templates, nil)
+
+	factory := jenkinsClient.ClientBuilderMock{}
+	factory.On("MakeNewClient", (*string)(nil)).Return(&jc, nil)
+
+	return &factory
+}
+
 func TestSpecUpdate(t *testing.T) {
 	agent1 := jenkinsApi.JenkinsAgent{
 		Spec: jenkinsApi.JenkinsAgentSpec{
@@ -78,8 +90,10 @@ func TestReconcile_Reconcile(t *testing.T) {
 	k8sClient := fake.NewClientBuilder().WithRuntimeObjects(&agent, &slavesCM, &agentCM).Build()
 
 	r := Reconcile{
-		client: k8sClient,
-		log:    &helper.LoggerMock{},
+		client:               k8sClient,
+		log:                  &helper.LoggerMock{},
+		jenkinsClientFactory: newTestClientFactory(jenkinsClient.PodTemplate{Cloud: "openshift", Name: "agent1", Label: "agent1"}),
+		now:                  time.Now,
 	}
 
 	nn := types.NamespacedName{Namespace: agent.Namespace, Name: agent.Name}
@@ -219,7 +233,7 @@ func TestReconcile_Reconcile_FailureSlavesNoConfigMap(t *testing.T) {
 
 	k8sClient := fake.NewClientBuilder().WithRuntimeObjects(&agent).Build()
 
-	r := NewReconciler(k8sClient, &helper.LoggerMock{})
+	r := NewReconciler(k8sClient, &helper.LoggerMock{}, &pmock.PlatformService{})
 
 	nn := types.NamespacedName{Namespace: agent.Namespace, Name: agent.Name}
 
@@ -231,3 +245,203 @@ func TestReconcile_Reconcile_FailureSlavesNoConfigMap(t *testing.T) {
 	require.NoError(t, k8sClient.Get(context.Background(), nn, &checkAgent))
 	require.Contains(t, checkAgent.Status.Value, "configmaps \"jenkins-slaves\" not found")
 }
+
+func TestReconcile_Reconcile_PodTemplate(t *testing.T) {
+	agent := jenkinsApi.JenkinsAgent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "go",
+			Namespace: "ns",
+		},
+		Spec: jenkinsApi.JenkinsAgentSpec{
+			Name:        "go",
+			PodTemplate: getTestPodTemplate(),
+		},
+	}
+
+	s := scheme.Scheme
+	utilruntime.Must(jenkinsApi.AddToScheme(s))
+	utilruntime.Must(corev1.AddToScheme(s))
+
+	slavesCM := corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      jenkins.SlavesTemplateName,
+			Namespace: agent.Namespace,
+		},
+	}
+
+	k8sClient := fake.NewClientBuilder().WithRuntimeObjects(&agent, &slavesCM).Build()
+
+	r := Reconcile{
+		client:               k8sClient,
+		log:                  &helper.LoggerMock{},
+		jenkinsClientFactory: newTestClientFactory(jenkinsClient.PodTemplate{Cloud: "openshift", Name: "go", Label: "go"}),
+		now:                  time.Now,
+	}
+
+	nn := types.NamespacedName{Namespace: agent.Namespace, Name: agent.Name}
+
+	_, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: nn})
+	require.NoError(t, err)
+
+	var checkAgent jenkinsApi.JenkinsAgent
+
+	require.NoError(t, k8sClient.Get(context.Background(), nn, &checkAgent))
+	require.Equal(t, helper.StatusSuccess, checkAgent.Status.Value)
+	require.Empty(t, checkAgent.Status.Errors)
+	require.True(t, checkAgent.Status.Registered)
+	require.NotNil(t, checkAgent.Status.LastSeen)
+
+	var checkSlavesCM corev1.ConfigMap
+
+	require.NoError(t, k8sClient.Get(context.Background(),
+		types.NamespacedName{Name: slavesCM.Name, Namespace: slavesCM.Namespace}, &checkSlavesCM))
+
+	want, err := renderPodTemplate(&agent.Spec)
+	require.NoError(t, err)
+	require.Equal(t, want, checkSlavesCM.Data[agent.Spec.SalvesKey()])
+}
+
+func TestReconcile_Reconcile_InvalidPodTemplate(t *testing.T) {
+	agent := jenkinsApi.JenkinsAgent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "go",
+			Namespace: "ns",
+		},
+		Spec: jenkinsApi.JenkinsAgentSpec{
+			Name:        "go",
+			PodTemplate: &jenkinsApi.JenkinsAgentPodTemplate{},
+		},
+	}
+
+	s := scheme.Scheme
+	utilruntime.Must(jenkinsApi.AddToScheme(s))
+	utilruntime.Must(corev1.AddToScheme(s))
+
+	slavesCM := corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      jenkins.SlavesTemplateName,
+			Namespace: agent.Namespace,
+		},
+	}
+
+	k8sClient := fake.NewClientBuilder().WithRuntimeObjects(&agent, &slavesCM).Build()
+
+	r := Reconcile{
+		client: k8sClient,
+		log:    &helper.LoggerMock{},
+	}
+
+	nn := types.NamespacedName{Namespace: agent.Namespace, Name: agent.Name}
+
+	res, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: nn})
+	require.NoError(t, err)
+	require.Equal(t, helper.DefaultRequeueTime*time.Second, res.RequeueAfter)
+
+	var checkAgent jenkinsApi.JenkinsAgent
+
+	require.NoError(t, k8sClient.Get(context.Background(), nn, &checkAgent))
+	require.Equal(t, "pod template is invalid: template.spec.containers must not be empty", checkAgent.Status.Value)
+	require.Equal(t, []string{"template.spec.containers must not be empty"}, checkAgent.Status.Errors)
+
+	var checkSlavesCM corev1.ConfigMap
+
+	require.NoError(t, k8sClient.Get(context.Background(),
+		types.NamespacedName{Name: slavesCM.Name, Namespace: slavesCM.Namespace}, &checkSlavesCM))
+	require.Empty(t, checkSlavesCM.Data)
+}
+
+func TestReconcile_Reconcile_NotRegistered(t *testing.T) {
+	agent := jenkinsApi.JenkinsAgent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "go",
+			Namespace: "ns",
+		},
+		Spec: jenkinsApi.JenkinsAgentSpec{
+			Name:        "go",
+			PodTemplate: getTestPodTemplate(),
+		},
+		Status: jenkinsApi.JenkinsAgentStatus{
+			Registered: true,
+		},
+	}
+
+	s := scheme.Scheme
+	utilruntime.Must(jenkinsApi.AddToScheme(s))
+	utilruntime.Must(corev1.AddToScheme(s))
+
+	slavesCM := corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      jenkins.SlavesTemplateName,
+			Namespace: agent.Namespace,
+		},
+	}
+
+	k8sClient := fake.NewClientBuilder().WithRuntimeObjects(&agent, &slavesCM).Build()
+
+	r := Reconcile{
+		client:               k8sClient,
+		log:                  &helper.LoggerMock{},
+		jenkinsClientFactory: newTestClientFactory(jenkinsClient.PodTemplate{Cloud: "openshift", Name: "maven", Label: "maven"}),
+		now:                  time.Now,
+	}
+
+	nn := types.NamespacedName{Namespace: agent.Namespace, Name: agent.Name}
+
+	res, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: nn})
+	require.NoError(t, err)
+	require.Equal(t, helper.DefaultRequeueTime*time.Second, res.RequeueAfter)
+
+	var checkAgent jenkinsApi.JenkinsAgent
+
+	require.NoError(t, k8sClient.Get(context.Background(), nn, &checkAgent))
+	require.Equal(t, `template label "go" is not registered in the Jenkins kubernetes clouds`, checkAgent.Status.Value)
+	require.False(t, checkAgent.Status.Registered)
+	require.Nil(t, checkAgent.Status.LastSeen)
+}
+
+func TestTemplateLabel(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		spec jenkinsApi.JenkinsAgentSpec
+		want string
+	}{
+		{
+			name: "should use pod template label",
+			spec: jenkinsApi.JenkinsAgentSpec{Name: "go", PodTemplate: &jenkinsApi.JenkinsAgentPodTemplate{Label: "golang"}},
+			want: "golang",
+		},
+		{
+			name: "should use raw template label",
+			spec: jenkinsApi.JenkinsAgentSpec{
+				Name:     "maven",
+				Template: "<org.csanchez.jenkins.plugins.kubernetes.PodTemplate><label>maven java</label></org.csanchez.jenkins.plugins.kubernetes.PodTemplate>",
+			},
+			want: "maven java",
+		},
+		{
+			name: "should use agent name",
+			spec: jenkinsApi.JenkinsAgentSpec{Name: "npm", Template: "not xml"},
+			want: "npm",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tt.want, templateLabel(&tt.spec))
+		})
+	}
+}
+
+func TestIsLabelRegistered(t *testing.T) {
+	templates := []jenkinsClient.PodTemplate{{Cloud: "openshift", Name: "maven", Label: "maven java"}}
+
+	require.True(t, isLabelRegistered(templates, "java maven"))
+	require.True(t, isLabelRegistered(templates, "maven"))
+	require.False(t, isLabelRegistered(templates, "maven go"))
+}
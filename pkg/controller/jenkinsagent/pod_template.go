@@ -0,0 +1,133 @@
+package jenkinsagent
+
+import (
+	"encoding/xml"
+	"fmt"
+	"math"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+)
+
+const yamlMergeStrategyOverrides = "org.csanchez.jenkins.plugins.kubernetes.pod.yaml.Overrides"
+
+type classAttr struct {
+	Class string `xml:"class,attr"`
+}
+
+// podTemplateXML is the Kubernetes plugin pod template, the pod is defined by the raw YAML.
+type podTemplateXML struct {
+	XMLName           xml.Name  `xml:"org.csanchez.jenkins.plugins.kubernetes.PodTemplate"`
+	Name              string    `xml:"name"`
+	Label             string    `xml:"label"`
+	IdleMinutes       int32     `xml:"idleMinutes"`
+	InstanceCap       int32     `xml:"instanceCap"`
+	NodeUsageMode     string    `xml:"nodeUsageMode"`
+	YAML              string    `xml:"yaml"`
+	YAMLMergeStrategy classAttr `xml:"yamlMergeStrategy"`
+	ShowRawYAML       bool      `xml:"showRawYaml"`
+}
+
+type podMetadata struct {
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type podYAML struct {
+	APIVersion string         `json:"apiVersion"`
+	Kind       string         `json:"kind"`
+	Metadata   podMetadata    `json:"metadata"`
+	Spec       corev1.PodSpec `json:"spec"`
+}
+
+// validatePodTemplate returns the problems found in the pod template.
+func validatePodTemplate(pt *jenkinsApi.JenkinsAgentPodTemplate) []string {
+	var errs []string
+
+	if strings.ContainsAny(pt.Label, " \t\n") {
+		errs = append(errs, fmt.Sprintf("label %q must not contain whitespace", pt.Label))
+	}
+
+	if pt.IdleMinutes < 0 {
+		errs = append(errs, "idleMinutes must not be negative")
+	}
+
+	if pt.InstanceCap != nil && *pt.InstanceCap < 0 {
+		errs = append(errs, "instanceCap must not be negative")
+	}
+
+	if len(pt.Template.Spec.Containers) == 0 {
+		errs = append(errs, "template.spec.containers must not be empty")
+	}
+
+	names := make(map[string]bool)
+
+	for i := range pt.Template.Spec.Containers {
+		c := &pt.Template.Spec.Containers[i]
+
+		switch {
+		case c.Name == "":
+			errs = append(errs, fmt.Sprintf("template.spec.containers[%d].name is required", i))
+		case names[c.Name]:
+			errs = append(errs, fmt.Sprintf("template.spec.containers[%d].name %q is duplicated", i, c.Name))
+		}
+
+		names[c.Name] = true
+
+		// the default agent image is used for the jnlp container.
+		if c.Image == "" && c.Name != "jnlp" {
+			errs = append(errs, fmt.Sprintf("template.spec.containers[%d].image is required", i))
+		}
+	}
+
+	return errs
+}
+
+// renderPodTemplate generates the Kubernetes plugin template of the agent.
+func renderPodTemplate(spec *jenkinsApi.JenkinsAgentSpec) (string, error) {
+	pt := spec.PodTemplate
+
+	// only labels and annotations of the metadata are merged into the agent pod.
+	pod := podYAML{
+		APIVersion: "v1",
+		Kind:       "Pod",
+		Metadata: podMetadata{
+			Labels:      pt.Template.Labels,
+			Annotations: pt.Template.Annotations,
+		},
+		Spec: pt.Template.Spec,
+	}
+
+	data, err := yaml.Marshal(pod)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal pod template: %w", err)
+	}
+
+	label := pt.Label
+	if label == "" {
+		label = spec.Name
+	}
+
+	instanceCap := int32(math.MaxInt32)
+	if pt.InstanceCap != nil {
+		instanceCap = *pt.InstanceCap
+	}
+
+	out, err := xml.MarshalIndent(podTemplateXML{
+		Name:              spec.Name,
+		Label:             label,
+		IdleMinutes:       pt.IdleMinutes,
+		InstanceCap:       instanceCap,
+		NodeUsageMode:     "NORMAL",
+		YAML:              string(data),
+		YAMLMergeStrategy: classAttr{Class: yamlMergeStrategyOverrides},
+	}, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal pod template: %w", err)
+	}
+
+	return string(out), nil
+}
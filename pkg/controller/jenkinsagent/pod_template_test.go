@@ -0,0 +1,114 @@
+package jenkinsagent
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+)
+
+func getTestPodTemplate() *jenkinsApi.JenkinsAgentPodTemplate {
+	instanceCap := int32(3)
+
+	return &jenkinsApi.JenkinsAgentPodTemplate{
+		IdleMinutes: 5,
+		InstanceCap: &instanceCap,
+		Template: corev1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "go-agent"}},
+			Spec: corev1.PodSpec{
+				ServiceAccountName: "jenkins",
+				Containers: []corev1.Container{
+					{Name: "jnlp", Image: "epamedp/edp-jenkins-go-agent:3.0.17"},
+				},
+			},
+		},
+	}
+}
+
+func TestRenderPodTemplate(t *testing.T) {
+	out, err := renderPodTemplate(&jenkinsApi.JenkinsAgentSpec{Name: "go", PodTemplate: getTestPodTemplate()})
+	require.NoError(t, err)
+
+	var pt podTemplateXML
+	require.NoError(t, xml.Unmarshal([]byte(out), &pt))
+
+	require.Equal(t, "go", pt.Name)
+	require.Equal(t, "go", pt.Label)
+	require.Equal(t, int32(5), pt.IdleMinutes)
+	require.Equal(t, int32(3), pt.InstanceCap)
+	require.Equal(t, yamlMergeStrategyOverrides, pt.YAMLMergeStrategy.Class)
+	require.Equal(t, `apiVersion: v1
+kind: Pod
+metadata:
+  labels:
+    app: go-agent
+spec:
+  containers:
+  - image: epamedp/edp-jenkins-go-agent:3.0.17
+    name: jnlp
+    resources: {}
+  serviceAccountName: jenkins
+`, pt.YAML)
+}
+
+func TestValidatePodTemplate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		modify func(pt *jenkinsApi.JenkinsAgentPodTemplate)
+		want   []string
+	}{
+		{
+			name:   "should be valid",
+			modify: func(pt *jenkinsApi.JenkinsAgentPodTemplate) {},
+		},
+		{
+			name: "should allow jnlp container without image",
+			modify: func(pt *jenkinsApi.JenkinsAgentPodTemplate) {
+				pt.Template.Spec.Containers[0].Image = ""
+			},
+		},
+		{
+			name: "should report all problems",
+			modify: func(pt *jenkinsApi.JenkinsAgentPodTemplate) {
+				pt.Label = "go agent"
+				pt.IdleMinutes = -1
+				pt.Template.Spec.Containers = append(pt.Template.Spec.Containers,
+					corev1.Container{Name: "jnlp", Image: "agent"},
+					corev1.Container{Name: "docker"},
+				)
+			},
+			want: []string{
+				`label "go agent" must not contain whitespace`,
+				"idleMinutes must not be negative",
+				`template.spec.containers[1].name "jnlp" is duplicated`,
+				"template.spec.containers[2].image is required",
+			},
+		},
+		{
+			name: "should require containers",
+			modify: func(pt *jenkinsApi.JenkinsAgentPodTemplate) {
+				pt.Template.Spec.Containers = nil
+			},
+			want: []string{"template.spec.containers must not be empty"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			pt := getTestPodTemplate()
+			tt.modify(pt)
+
+			require.Equal(t, tt.want, validatePodTemplate(pt))
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package authorization
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// javaOnlyGroups are group constructs supported by java.util.regex but not by Go.
+// They are replaced with a non-capturing group, which is enough to check the syntax.
+var javaOnlyGroups = []string{"(?<=", "(?<!", "(?=", "(?!", "(?>"}
+
+// ValidatePattern checks that the pattern is a valid java.util.regex pattern,
+// as it is compiled by the Jenkins role strategy plugin.
+// Java-only constructs (lookarounds, atomic groups, possessive quantifiers, backreferences, Java character classes)
+// are rewritten to their nearest Go equivalent before the pattern is compiled.
+func ValidatePattern(pattern string) error {
+	if _, err := regexp.Compile(toGoSyntax(pattern)); err != nil {
+		return fmt.Errorf("invalid pattern %q: %w", pattern, err)
+	}
+
+	return nil
+}
+
+func toGoSyntax(pattern string) string {
+	var b strings.Builder
+
+	inClass := false
+
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+
+		switch {
+		case c == '\\' && i+1 < len(pattern):
+			i = writeEscape(&b, pattern, i, inClass)
+		case c == '[' && !inClass:
+			inClass = true
+
+			b.WriteByte(c)
+		case c == ']' && inClass:
+			inClass = false
+
+			b.WriteByte(c)
+		case c == '(' && !inClass:
+			i = writeGroup(&b, pattern, i)
+		case isQuantifierEnd(c) && !inClass && i+1 < len(pattern) && pattern[i+1] == '+':
+			// possessive quantifier, e.g. a*+
+			b.WriteByte(c)
+
+			i++
+		default:
+			b.WriteByte(c)
+		}
+	}
+
+	return b.String()
+}
+
+// writeEscape writes the escape sequence started at i and returns the index of its last byte.
+func writeEscape(b *strings.Builder, pattern string, i int, inClass bool) int {
+	next := pattern[i+1]
+
+	switch {
+	case next >= '1' && next <= '9' && !inClass:
+		// backreference
+		b.WriteString("x")
+
+		return i + 1
+	case next == 'k' && i+2 < len(pattern) && pattern[i+2] == '<':
+		// named backreference \k<name>
+		if end := strings.IndexByte(pattern[i:], '>'); end != -1 {
+			b.WriteString("x")
+
+			return i + end
+		}
+	case (next == 'p' || next == 'P') && i+2 < len(pattern) && pattern[i+2] == '{':
+		// Java character classes like \p{Lower} or \p{javaLetter}
+		if end := strings.IndexByte(pattern[i:], '}'); end != -1 {
+			b.WriteString("x")
+
+			return i + end
+		}
+	case next == 'c' && i+2 < len(pattern):
+		// control character \cX
+		b.WriteString("x")
+
+		return i + 2
+	case strings.IndexByte("hHRXeGZ", next) != -1:
+		b.WriteString("x")
+
+		return i + 1
+	}
+
+	b.WriteString(pattern[i : i+2])
+
+	return i + 1
+}
+
+// writeGroup writes the group opening started at i and returns the index of its last byte.
+func writeGroup(b *strings.Builder, pattern string, i int) int {
+	rest := pattern[i:]
+
+	for _, g := range javaOnlyGroups {
+		if strings.HasPrefix(rest, g) {
+			b.WriteString("(?:")
+
+			return i + len(g) - 1
+		}
+	}
+
+	if strings.HasPrefix(rest, "(?<") {
+		// named group
+		b.WriteString("(?P<")
+
+		return i + 2
+	}
+
+	b.WriteByte('(')
+
+	return i
+}
+
+func isQuantifierEnd(c byte) bool {
+	return c == '*' || c == '+' || c == '?' || c == '}'
+}
@@ -0,0 +1,41 @@
+package authorization
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePattern(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		pattern string
+		wantErr require.ErrorAssertionFunc
+	}{
+		{name: "simple pattern", pattern: "^team-a/.*", wantErr: require.NoError},
+		{name: "empty pattern", pattern: "", wantErr: require.NoError},
+		{name: "lookahead", pattern: "^(?!admin).*", wantErr: require.NoError},
+		{name: "lookbehind", pattern: ".*(?<=-prod)$", wantErr: require.NoError},
+		{name: "possessive quantifier", pattern: "a*+b", wantErr: require.NoError},
+		{name: "backreference", pattern: "(a)\\1", wantErr: require.NoError},
+		{name: "named group and backreference", pattern: "(?<team>[a-z]+)/\\k<team>", wantErr: require.NoError},
+		{name: "java character class", pattern: "\\p{Lower}+", wantErr: require.NoError},
+		{name: "escaped bracket in class", pattern: "[\\]a]+", wantErr: require.NoError},
+		{name: "unclosed group", pattern: "(team-a/.*", wantErr: require.Error},
+		{name: "unclosed class", pattern: "[a-z", wantErr: require.Error},
+		{name: "dangling quantifier", pattern: "*team", wantErr: require.Error},
+		{name: "nested quantifier", pattern: "a**", wantErr: require.Error},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.wantErr(t, ValidatePattern(tt.pattern))
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package authorization
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/epam/edp-jenkins-operator/v2/pkg/client/jenkins"
+)
+
+// PermissionCache caches IDs of the permissions known to Jenkins instances,
+// so they are not fetched from Jenkins on every reconciliation.
+type PermissionCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]permissionEntry
+	now     func() time.Time
+}
+
+type permissionEntry struct {
+	ids       map[string]struct{}
+	fetchedAt time.Time
+}
+
+func NewPermissionCache(ttl time.Duration) *PermissionCache {
+	return &PermissionCache{
+		ttl:     ttl,
+		entries: make(map[string]permissionEntry),
+		now:     time.Now,
+	}
+}
+
+// CacheKey returns the key identifying the Jenkins instance resolved for the owner name in the namespace.
+func CacheKey(namespace string, ownerName *string) string {
+	if ownerName == nil {
+		return namespace
+	}
+
+	return fmt.Sprintf("%s/%s", namespace, *ownerName)
+}
+
+// Get returns permission IDs of the Jenkins instance, fetching them with jc when they are missing or expired.
+func (c *PermissionCache) Get(key string, jc jenkins.ClientInterface) (map[string]struct{}, error) {
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+
+	if ok && c.now().Sub(entry.fetchedAt) < c.ttl {
+		return entry.ids, nil
+	}
+
+	ids, err := jc.GetPermissionIDs()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get permission ids from jenkins: %w", err)
+	}
+
+	entry = permissionEntry{
+		ids:       make(map[string]struct{}, len(ids)),
+		fetchedAt: c.now(),
+	}
+
+	for _, id := range ids {
+		entry.ids[id] = struct{}{}
+	}
+
+	c.mu.Lock()
+	c.entries[key] = entry
+	c.mu.Unlock()
+
+	return entry.ids, nil
+}
@@ -0,0 +1,43 @@
+package authorization
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/epam/edp-jenkins-operator/v2/pkg/client/jenkins"
+)
+
+func TestPermissionCache_Get(t *testing.T) {
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache := NewPermissionCache(time.Minute)
+	cache.now = func() time.Time { return now }
+
+	jc := jenkins.ClientMock{}
+	jc.On("GetPermissionIDs").Return([]string{"p1", "p2"}, nil).Once()
+
+	ids, err := cache.Get("ns", &jc)
+	require.NoError(t, err)
+	require.Equal(t, map[string]struct{}{"p1": {}, "p2": {}}, ids)
+
+	_, err = cache.Get("ns", &jc)
+	require.NoError(t, err)
+	jc.AssertNumberOfCalls(t, "GetPermissionIDs", 1)
+
+	now = now.Add(2 * time.Minute)
+
+	jc.On("GetPermissionIDs").Return(nil, errors.New("jenkins is down")).Once()
+
+	_, err = cache.Get("ns", &jc)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "jenkins is down")
+}
+
+func TestCacheKey(t *testing.T) {
+	owner := "jenkins"
+
+	require.Equal(t, "ns", CacheKey("ns", nil))
+	require.Equal(t, "ns/jenkins", CacheKey("ns", &owner))
+}
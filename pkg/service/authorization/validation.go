@@ -0,0 +1,60 @@
+package authorization
+
+import (
+	"fmt"
+	"strings"
+
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+)
+
+// ValidationResult contains invalid entries of the JenkinsAuthorizationRole spec.
+type ValidationResult struct {
+	InvalidPermissions []string
+	PatternError       string
+}
+
+func (r *ValidationResult) IsValid() bool {
+	return len(r.InvalidPermissions) == 0 && r.PatternError == ""
+}
+
+func (r *ValidationResult) String() string {
+	var msgs []string
+
+	if len(r.InvalidPermissions) > 0 {
+		msgs = append(msgs, fmt.Sprintf("unknown permissions: %s", strings.Join(r.InvalidPermissions, ", ")))
+	}
+
+	if r.PatternError != "" {
+		msgs = append(msgs, r.PatternError)
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
+// ValidateRole checks the pattern and permissions of the role.
+// Permissions are checked against knownPermissions only if it is not nil.
+func ValidateRole(spec *jenkinsApi.JenkinsAuthorizationRoleSpec, knownPermissions map[string]struct{}) ValidationResult {
+	var result ValidationResult
+
+	for _, p := range spec.Permissions {
+		if p == "" || strings.Contains(p, ",") {
+			result.InvalidPermissions = append(result.InvalidPermissions, p)
+
+			continue
+		}
+
+		if knownPermissions == nil {
+			continue
+		}
+
+		if _, ok := knownPermissions[p]; !ok {
+			result.InvalidPermissions = append(result.InvalidPermissions, p)
+		}
+	}
+
+	if err := ValidatePattern(spec.Pattern); err != nil {
+		result.PatternError = err.Error()
+	}
+
+	return result
+}
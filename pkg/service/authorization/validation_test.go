@@ -0,0 +1,59 @@
+package authorization
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+)
+
+func TestValidateRole(t *testing.T) {
+	t.Parallel()
+
+	known := map[string]struct{}{"hudson.model.Item.Read": {}}
+
+	tests := []struct {
+		name     string
+		spec     jenkinsApi.JenkinsAuthorizationRoleSpec
+		known    map[string]struct{}
+		want     []string
+		wantPErr bool
+	}{
+		{
+			name:  "valid role",
+			spec:  jenkinsApi.JenkinsAuthorizationRoleSpec{Permissions: []string{"hudson.model.Item.Read"}, Pattern: ".*"},
+			known: known,
+		},
+		{
+			name:  "unknown permission",
+			spec:  jenkinsApi.JenkinsAuthorizationRoleSpec{Permissions: []string{"hudson.model.Item.Raed"}, Pattern: ".*"},
+			known: known,
+			want:  []string{"hudson.model.Item.Raed"},
+		},
+		{
+			name: "unknown permissions are not checked without jenkins",
+			spec: jenkinsApi.JenkinsAuthorizationRoleSpec{Permissions: []string{"hudson.model.Item.Raed", "a,b"}},
+			want: []string{"a,b"},
+		},
+		{
+			name:     "invalid pattern",
+			spec:     jenkinsApi.JenkinsAuthorizationRoleSpec{Pattern: "(.*"},
+			wantPErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := ValidateRole(&tt.spec, tt.known)
+
+			require.Equal(t, tt.want, got.InvalidPermissions)
+			require.Equal(t, tt.wantPErr, got.PatternError != "")
+			require.Equal(t, tt.want == nil && !tt.wantPErr, got.IsValid())
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/go-logr/logr"
+	admissionv1 "k8s.io/api/admission/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/client/jenkins"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/service/platform"
+)
+
+const (
+	// RoleValidationPath is the path the JenkinsAuthorizationRole validating webhook is served on.
+	RoleValidationPath = "/validate-v2-edp-epam-com-v1-jenkinsauthorizationrole"
+
+	// PermissionCacheTTL is how long permission IDs fetched from Jenkins are reused.
+	PermissionCacheTTL = 10 * time.Minute
+)
+
+// RoleValidator is the admission handler rejecting JenkinsAuthorizationRoles with invalid pattern or permissions.
+// If Jenkins can not be reached, only the pattern is validated.
+type RoleValidator struct {
+	jenkinsClientFactory jenkins.ClientFactory
+	permissions          *PermissionCache
+	decoder              *admission.Decoder
+	log                  logr.Logger
+}
+
+func NewRoleValidator(k8sCl client.Client, ps platform.PlatformService, log logr.Logger) *RoleValidator {
+	return &RoleValidator{
+		jenkinsClientFactory: jenkins.MakeClientBuilder(ps, k8sCl),
+		permissions:          NewPermissionCache(PermissionCacheTTL),
+		log:                  log.WithName("jenkins_authorizationrole_validator"),
+	}
+}
+
+// InjectDecoder implements admission.DecoderInjector.
+func (v *RoleValidator) InjectDecoder(d *admission.Decoder) error {
+	v.decoder = d
+
+	return nil
+}
+
+func (v *RoleValidator) Handle(_ context.Context, req admission.Request) admission.Response {
+	if req.Operation == admissionv1.Delete {
+		return admission.Allowed("")
+	}
+
+	role := &jenkinsApi.JenkinsAuthorizationRole{}
+	if err := v.decoder.Decode(req, role); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	// the role being deleted or the role with the unchanged spec, e.g. on the finalizer removal,
+	// is allowed even if its permissions are not known by Jenkins anymore.
+	if !role.GetDeletionTimestamp().IsZero() {
+		return admission.Allowed("")
+	}
+
+	if req.Operation == admissionv1.Update {
+		oldRole := &jenkinsApi.JenkinsAuthorizationRole{}
+		if err := v.decoder.DecodeRaw(req.OldObject, oldRole); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+
+		if reflect.DeepEqual(oldRole.Spec, role.Spec) {
+			return admission.Allowed("")
+		}
+	}
+
+	var warnings []string
+
+	known, err := v.knownPermissions(role)
+	if err != nil {
+		v.log.Error(err, "unable to get permissions from jenkins", "role", role.Name)
+		warnings = append(warnings, fmt.Sprintf("permissions are not validated: %s", err))
+	}
+
+	result := ValidateRole(&role.Spec, known)
+	if !result.IsValid() {
+		return admission.Denied(result.String())
+	}
+
+	return admission.Allowed("").WithWarnings(warnings...)
+}
+
+func (v *RoleValidator) knownPermissions(role *jenkinsApi.JenkinsAuthorizationRole) (map[string]struct{}, error) {
+	jc, err := v.jenkinsClientFactory.MakeNewClient(&role.ObjectMeta, role.Spec.OwnerName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create jenkins client: %w", err)
+	}
+
+	return v.permissions.Get(CacheKey(role.Namespace, role.Spec.OwnerName), jc)
+}
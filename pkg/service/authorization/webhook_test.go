@@ -0,0 +1,147 @@
+package authorization
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/client/jenkins"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/controller/helper"
+)
+
+func newRoleRequest(t *testing.T, role *jenkinsApi.JenkinsAuthorizationRole) admission.Request {
+	t.Helper()
+
+	raw, err := json.Marshal(role)
+	require.NoError(t, err)
+
+	return admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+		Operation: admissionv1.Create,
+		Object:    runtime.RawExtension{Raw: raw},
+	}}
+}
+
+func newTestRoleValidator(t *testing.T, jc *jenkins.ClientMock, clientErr error) *RoleValidator {
+	t.Helper()
+
+	s := runtime.NewScheme()
+	require.NoError(t, jenkinsApi.AddToScheme(s))
+
+	decoder, err := admission.NewDecoder(s)
+	require.NoError(t, err)
+
+	factory := jenkins.ClientBuilderMock{}
+	if clientErr != nil {
+		factory.On("MakeNewClient", (*string)(nil)).Return(nil, clientErr)
+	} else {
+		factory.On("MakeNewClient", (*string)(nil)).Return(jc, nil)
+	}
+
+	v := &RoleValidator{
+		jenkinsClientFactory: &factory,
+		permissions:          NewPermissionCache(PermissionCacheTTL),
+		log:                  &helper.LoggerMock{},
+	}
+	require.NoError(t, v.InjectDecoder(decoder))
+
+	return v
+}
+
+func TestRoleValidator_Handle(t *testing.T) {
+	role := &jenkinsApi.JenkinsAuthorizationRole{
+		Spec: jenkinsApi.JenkinsAuthorizationRoleSpec{
+			Name:        "developer",
+			Permissions: []string{"hudson.model.Item.Read", "hudson.model.Item.Raed"},
+			Pattern:     ".*",
+		},
+	}
+	role.Namespace = "ns"
+
+	jc := jenkins.ClientMock{}
+	jc.On("GetPermissionIDs").Return([]string{"hudson.model.Item.Read"}, nil)
+
+	rsp := newTestRoleValidator(t, &jc, nil).Handle(context.Background(), newRoleRequest(t, role))
+	require.False(t, rsp.Allowed)
+	require.Contains(t, string(rsp.Result.Reason), "hudson.model.Item.Raed")
+}
+
+func TestRoleValidator_Handle_JenkinsUnavailable(t *testing.T) {
+	role := &jenkinsApi.JenkinsAuthorizationRole{
+		Spec: jenkinsApi.JenkinsAuthorizationRoleSpec{
+			Name:        "developer",
+			Permissions: []string{"hudson.model.Item.Raed"},
+			Pattern:     ".*",
+		},
+	}
+
+	v := newTestRoleValidator(t, nil, errors.New("jenkins not found"))
+
+	rsp := v.Handle(context.Background(), newRoleRequest(t, role))
+	require.True(t, rsp.Allowed)
+	require.Len(t, rsp.Warnings, 1)
+
+	role.Spec.Pattern = "[a-"
+
+	rsp = v.Handle(context.Background(), newRoleRequest(t, role))
+	require.False(t, rsp.Allowed)
+	require.Contains(t, string(rsp.Result.Reason), "invalid pattern")
+}
+
+func TestRoleValidator_Handle_Update(t *testing.T) {
+	role := &jenkinsApi.JenkinsAuthorizationRole{
+		Spec: jenkinsApi.JenkinsAuthorizationRoleSpec{
+			Name:        "developer",
+			Permissions: []string{"hudson.model.Item.Read", "org.jenkinsci.plugins.removed.Permission"},
+			Pattern:     ".*",
+		},
+	}
+	role.Namespace = "ns"
+	role.Finalizers = []string{"finalizer"}
+
+	jc := jenkins.ClientMock{}
+	jc.On("GetPermissionIDs").Return([]string{"hudson.model.Item.Read"}, nil)
+
+	v := newTestRoleValidator(t, &jc, nil)
+
+	oldRaw, err := json.Marshal(role)
+	require.NoError(t, err)
+
+	// the finalizer of the role with the unchanged spec is removed.
+	role.Finalizers = nil
+	req := newRoleRequest(t, role)
+	req.Operation = admissionv1.Update
+	req.OldObject = runtime.RawExtension{Raw: oldRaw}
+
+	rsp := v.Handle(context.Background(), req)
+	require.True(t, rsp.Allowed)
+	jc.AssertNotCalled(t, "GetPermissionIDs")
+
+	// the role being deleted is allowed even if its spec is changed.
+	role.Spec.Permissions = append(role.Spec.Permissions, "hudson.model.Item.Raed")
+	now := metav1.Now()
+	role.DeletionTimestamp = &now
+	req = newRoleRequest(t, role)
+	req.Operation = admissionv1.Update
+	req.OldObject = runtime.RawExtension{Raw: oldRaw}
+
+	rsp = v.Handle(context.Background(), req)
+	require.True(t, rsp.Allowed)
+
+	// the changed spec is validated.
+	role.DeletionTimestamp = nil
+	req = newRoleRequest(t, role)
+	req.Operation = admissionv1.Update
+	req.OldObject = runtime.RawExtension{Raw: oldRaw}
+
+	rsp = v.Handle(context.Background(), req)
+	require.False(t, rsp.Allowed)
+	require.Contains(t, string(rsp.Result.Reason), "org.jenkinsci.plugins.removed.Permission")
+}
@@ -0,0 +1,3 @@
+// Package buildevents receives the build events pushed by Jenkins and triggers reconciliation
+// of the JenkinsJobBuildRuns waiting for the builds.
+package buildevents
@@ -0,0 +1,275 @@
+package buildevents
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/util/consts"
+	plutil "github.com/epam/edp-jenkins-operator/v2/pkg/util/platform"
+)
+
+const (
+	// Path is a base path of the build events endpoint.
+	// The full path is /build-events/<namespace>/<jenkins name>.
+	Path = "/build-events/"
+
+	// TokenSecretSuffix is a suffix of the secret which holds the build events token for the Jenkins instance.
+	TokenSecretSuffix = "build-events-token"
+	// TokenSecretKey is a key of the token in the build events secret.
+	TokenSecretKey = "token"
+
+	eventsBufferSize  = 100
+	maxBodySize       = 1 << 20
+	readHeaderTimeout = 10 * time.Second
+	shutdownTimeout   = 5 * time.Second
+	bearerPrefix      = "Bearer "
+)
+
+// Event is a build event sent by Jenkins.
+type Event struct {
+	// Job is a full name of the Jenkins job, e.g. folder/job.
+	Job         string `json:"job"`
+	BuildNumber int64  `json:"buildNumber"`
+	// Phase is a build phase: STARTED or FINALIZED.
+	Phase string `json:"phase"`
+	// +optional
+	Result string `json:"result,omitempty"`
+}
+
+// Server receives build events from Jenkins and triggers reconciliation of the related JenkinsJobBuildRuns.
+type Server struct {
+	addr   string
+	client client.Client
+	events chan event.GenericEvent
+	log    logr.Logger
+}
+
+func NewServer(addr string, k8sClient client.Client, log logr.Logger) *Server {
+	return &Server{
+		addr:   addr,
+		client: k8sClient,
+		events: make(chan event.GenericEvent, eventsBufferSize),
+		log:    log.WithName("build-events"),
+	}
+}
+
+// Events returns a channel with JenkinsJobBuildRuns which should be reconciled.
+func (s *Server) Events() <-chan event.GenericEvent {
+	return s.events
+}
+
+// Start runs the HTTP server until the context is done.
+func (s *Server) Start(ctx context.Context) error {
+	mux := http.NewServeMux()
+	mux.Handle(Path, s)
+
+	srv := &http.Server{
+		Addr:              s.addr,
+		Handler:           mux,
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		s.log.Info("starting build events server", "addr", s.addr)
+
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errCh <- err
+		}
+
+		close(errCh)
+	}()
+
+	select {
+	case err := <-errCh:
+		if err != nil {
+			return fmt.Errorf("failed to run build events server: %w", err)
+		}
+
+		return nil
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("failed to shutdown build events server: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, Path), "/"), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		http.NotFound(w, r)
+
+		return
+	}
+
+	namespace, jenkinsName := parts[0], parts[1]
+	log := s.log.WithValues("namespace", namespace, "jenkins", jenkinsName)
+
+	if err := s.authorize(r, namespace, jenkinsName); err != nil {
+		log.Info("unauthorized build event", "reason", err.Error())
+		w.WriteHeader(http.StatusUnauthorized)
+
+		return
+	}
+
+	var e Event
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodySize)).Decode(&e); err != nil || e.Job == "" {
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+
+	log.V(2).Info("build event has been received", "job", e.Job, "build", e.BuildNumber, "phase", e.Phase)
+
+	if err := s.enqueueRuns(r.Context(), namespace, jenkinsName, &e); err != nil {
+		log.Error(err, "failed to process build event", "job", e.Job)
+		w.WriteHeader(http.StatusInternalServerError)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (s *Server) authorize(r *http.Request, namespace, jenkinsName string) error {
+	header := r.Header.Get("Authorization")
+	if !strings.HasPrefix(header, bearerPrefix) {
+		return errors.New("bearer token is missing")
+	}
+
+	secretName := fmt.Sprintf("%s-%s", jenkinsName, TokenSecretSuffix)
+
+	var secret corev1.Secret
+	if err := s.client.Get(r.Context(), types.NamespacedName{Namespace: namespace, Name: secretName}, &secret); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return fmt.Errorf("secret %s is not found", secretName)
+		}
+
+		return fmt.Errorf("failed to get secret %s: %w", secretName, err)
+	}
+
+	expected := secret.Data[TokenSecretKey]
+	actual := []byte(strings.TrimPrefix(header, bearerPrefix))
+
+	if len(expected) == 0 || subtle.ConstantTimeCompare(expected, actual) != 1 {
+		return errors.New("token is invalid")
+	}
+
+	return nil
+}
+
+// enqueueRuns enqueues the JenkinsJobBuildRuns of the Jenkins instance which wait for the build of the event.
+func (s *Server) enqueueRuns(ctx context.Context, namespace, jenkinsName string, e *Event) error {
+	var runs jenkinsApi.JenkinsJobBuildRunList
+	if err := s.client.List(ctx, &runs, client.InNamespace(namespace)); err != nil {
+		return fmt.Errorf("failed to list JenkinsJobBuildRuns: %w", err)
+	}
+
+	job := NormalizeJobPath(e.Job)
+	defaultOwner := ""
+
+	for i := range runs.Items {
+		run := &runs.Items[i]
+
+		if NormalizeJobPath(run.Spec.JobPath) != job || !waitsForBuild(run, e.BuildNumber) {
+			continue
+		}
+
+		owner, err := s.ownerName(ctx, run, &defaultOwner)
+		if err != nil {
+			return err
+		}
+
+		if owner != jenkinsName {
+			continue
+		}
+
+		select {
+		case s.events <- event.GenericEvent{Object: run}:
+		case <-ctx.Done():
+			return fmt.Errorf("failed to enqueue JenkinsJobBuildRun %s: %w", run.Name, ctx.Err())
+		}
+	}
+
+	return nil
+}
+
+// ownerName returns the name of the Jenkins instance which the run is triggered in.
+// The owner is resolved in the same order as by the Jenkins client of the run:
+// the owner reference, the owner name and the first Jenkins instance of the namespace.
+func (s *Server) ownerName(ctx context.Context, run *jenkinsApi.JenkinsJobBuildRun, defaultOwner *string) (string, error) {
+	if ow := plutil.GetOwnerReference(consts.JenkinsKind, run.GetOwnerReferences()); ow != nil {
+		return ow.Name, nil
+	}
+
+	if run.Spec.OwnerName != nil {
+		return *run.Spec.OwnerName, nil
+	}
+
+	if *defaultOwner == "" {
+		var list jenkinsApi.JenkinsList
+		if err := s.client.List(ctx, &list, client.InNamespace(run.Namespace)); err != nil {
+			return "", fmt.Errorf("failed to list Jenkins instances: %w", err)
+		}
+
+		if len(list.Items) > 0 {
+			*defaultOwner = list.Items[0].Name
+		}
+	}
+
+	return *defaultOwner, nil
+}
+
+func waitsForBuild(run *jenkinsApi.JenkinsJobBuildRun, buildNumber int64) bool {
+	switch run.Status.Status {
+	case jenkinsApi.JobBuildRunStatusCompleted,
+		jenkinsApi.JobBuildRunStatusFailed,
+		jenkinsApi.JobBuildRunStatusUpstreamFailed:
+		return false
+	}
+
+	return run.Status.BuildNumber == 0 || run.Status.BuildNumber <= buildNumber
+}
+
+// NormalizeJobPath converts a job path to the full job name, e.g. folder/job/name -> folder/name.
+func NormalizeJobPath(path string) string {
+	parts := strings.Split(strings.TrimPrefix(strings.Trim(path, "/"), "job/"), "/")
+	res := make([]string, 0, len(parts))
+
+	for i, p := range parts {
+		if i%2 == 1 && p == "job" {
+			continue
+		}
+
+		res = append(res, p)
+	}
+
+	return strings.Join(res, "/")
+}
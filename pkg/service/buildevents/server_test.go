@@ -0,0 +1,137 @@
+package buildevents
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+)
+
+const (
+	testNs    = "ns"
+	testToken = "secret-token"
+)
+
+func newTestServer(t *testing.T, runs ...*jenkinsApi.JenkinsJobBuildRun) *Server {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, jenkinsApi.AddToScheme(scheme))
+
+	builder := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "jenkins-" + TokenSecretSuffix, Namespace: testNs},
+			Data:       map[string][]byte{TokenSecretKey: []byte(testToken)},
+		},
+		&jenkinsApi.Jenkins{ObjectMeta: metav1.ObjectMeta{Name: "jenkins", Namespace: testNs}},
+		&jenkinsApi.Jenkins{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: testNs}},
+	)
+
+	for _, r := range runs {
+		builder = builder.WithObjects(r)
+	}
+
+	return NewServer(":0", builder.Build(), logr.Discard())
+}
+
+func sendEvent(s *Server, token, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, Path+testNs+"/jenkins", strings.NewReader(body))
+	req.Header.Set("Authorization", bearerPrefix+token)
+
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestServer_ServeHTTP_Unauthorized(t *testing.T) {
+	s := newTestServer(t)
+
+	rec := sendEvent(s, "wrong", `{"job":"folder/job1","buildNumber":1}`)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestServer_ServeHTTP_BadRequest(t *testing.T) {
+	s := newTestServer(t)
+
+	rec := sendEvent(s, testToken, `{"buildNumber":1}`)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestServer_ServeHTTP_EnqueuesRuns(t *testing.T) {
+	waiting := &jenkinsApi.JenkinsJobBuildRun{
+		ObjectMeta: metav1.ObjectMeta{Name: "waiting", Namespace: testNs},
+		Spec:       jenkinsApi.JenkinsJobBuildRunSpec{JobPath: "job/folder/job/job1"},
+		Status:     jenkinsApi.JenkinsJobBuildRunStatus{BuildNumber: 5, Status: jenkinsApi.JobBuildRunStatusCreated},
+	}
+	completed := &jenkinsApi.JenkinsJobBuildRun{
+		ObjectMeta: metav1.ObjectMeta{Name: "completed", Namespace: testNs},
+		Spec:       jenkinsApi.JenkinsJobBuildRunSpec{JobPath: "folder/job1"},
+		Status:     jenkinsApi.JenkinsJobBuildRunStatus{BuildNumber: 4, Status: jenkinsApi.JobBuildRunStatusCompleted},
+	}
+	otherJob := &jenkinsApi.JenkinsJobBuildRun{
+		ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: testNs},
+		Spec:       jenkinsApi.JenkinsJobBuildRunSpec{JobPath: "job2"},
+	}
+	owned := &jenkinsApi.JenkinsJobBuildRun{
+		ObjectMeta: metav1.ObjectMeta{Name: "owned", Namespace: testNs},
+		Spec:       jenkinsApi.JenkinsJobBuildRunSpec{JobPath: "folder/job1", OwnerName: strPtr("jenkins")},
+	}
+	otherJenkins := &jenkinsApi.JenkinsJobBuildRun{
+		ObjectMeta: metav1.ObjectMeta{Name: "other-jenkins", Namespace: testNs},
+		Spec:       jenkinsApi.JenkinsJobBuildRunSpec{JobPath: "folder/job1", OwnerName: strPtr("other")},
+	}
+	otherJenkinsRef := &jenkinsApi.JenkinsJobBuildRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "other-jenkins-ref",
+			Namespace:       testNs,
+			OwnerReferences: []metav1.OwnerReference{{Kind: "Jenkins", Name: "other"}},
+		},
+		Spec: jenkinsApi.JenkinsJobBuildRunSpec{JobPath: "folder/job1", OwnerName: strPtr("jenkins")},
+	}
+
+	s := newTestServer(t, waiting, completed, otherJob, owned, otherJenkins, otherJenkinsRef)
+
+	rec := sendEvent(s, testToken, `{"job":"folder/job1","buildNumber":5,"phase":"FINALIZED","result":"SUCCESS"}`)
+	require.Equal(t, http.StatusAccepted, rec.Code)
+
+	require.Len(t, s.events, 2)
+
+	var names []string
+	for len(s.events) > 0 {
+		names = append(names, (<-s.Events()).Object.GetName())
+	}
+
+	assert.ElementsMatch(t, []string{"waiting", "owned"}, names)
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func TestNormalizeJobPath(t *testing.T) {
+	tests := map[string]string{
+		"job1":                  "job1",
+		"folder/job1":           "folder/job1",
+		"job/folder/job/job1":   "folder/job1",
+		"/job/folder/job/job1/": "folder/job1",
+		"folder/job/job1":       "folder/job1",
+	}
+
+	for in, want := range tests {
+		assert.Equal(t, want, NormalizeJobPath(in), in)
+	}
+}
@@ -0,0 +1,285 @@
+package jenkins
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dchest/uniuri"
+	"github.com/robfig/cron/v3"
+	coreV1Api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+	jenkinsClient "github.com/epam/edp-jenkins-operator/v2/pkg/client/jenkins"
+	jenkinsDefaultSpec "github.com/epam/edp-jenkins-operator/v2/pkg/service/jenkins/spec"
+)
+
+const (
+	// adminTokenName is the name of the admin API token, GetAdminToken issues the first one with the same name.
+	adminTokenName = "admin"
+
+	defaultTokenGracePeriod = time.Hour
+
+	// stagedPasswordKey is the key of the admin password Secret the new password is stored in until it is applied.
+	stagedPasswordKey = "newPassword"
+)
+
+// RotateAdminCredentials replaces the admin password and API token according to the rotation schedule
+// and revokes replaced tokens after the grace period. It returns true if the instance status has been changed.
+func (j JenkinsServiceImpl) RotateAdminCredentials(instance *jenkinsApi.Jenkins) (*jenkinsApi.Jenkins, bool, error) {
+	rotation := instance.Spec.AdminCredentialsRotation
+	adminTokenSecretName := fmt.Sprintf(configMapStringFormat, instance.Name, jenkinsDefaultSpec.JenkinsTokenAnnotationSuffix)
+
+	// the operator authenticates with the admin password until the admin token is issued.
+	if instance.Status.AdminSecretName != adminTokenSecretName {
+		return instance, false, nil
+	}
+
+	if rotation == nil && (instance.Status.AdminCredentials == nil ||
+		len(instance.Status.AdminCredentials.PendingRevocations) == 0) {
+		return instance, false, nil
+	}
+
+	if instance.Status.AdminCredentials == nil {
+		instance.Status.AdminCredentials = &jenkinsApi.AdminCredentialsStatus{}
+	}
+
+	now := time.Now()
+	updated := false
+
+	if rotation != nil {
+		rotated, err := j.rotateIfDue(instance, rotation, adminTokenSecretName, now)
+		if err != nil {
+			return instance, false, err
+		}
+
+		updated = rotated
+	}
+
+	revoked, err := j.revokeReplacedAdminTokens(instance, now)
+	if err != nil {
+		return instance, updated, err
+	}
+
+	return instance, updated || revoked, nil
+}
+
+// rotateIfDue rotates the credentials if the scheduled time has come, it returns true if the status has been changed.
+func (j JenkinsServiceImpl) rotateIfDue(
+	instance *jenkinsApi.Jenkins,
+	rotation *jenkinsApi.AdminCredentialsRotation,
+	adminTokenSecretName string,
+	now time.Time,
+) (bool, error) {
+	schedule, err := cron.ParseStandard(rotation.Schedule)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse admin credentials rotation schedule %q: %w", rotation.Schedule, err)
+	}
+
+	gracePeriod := defaultTokenGracePeriod
+
+	if rotation.TokenGracePeriod != "" {
+		if gracePeriod, err = time.ParseDuration(rotation.TokenGracePeriod); err != nil {
+			return false, fmt.Errorf("failed to parse admin token grace period %q: %w", rotation.TokenGracePeriod, err)
+		}
+	}
+
+	status := instance.Status.AdminCredentials
+
+	since := instance.CreationTimestamp.Time
+	if status.LastRotationTime != nil {
+		since = status.LastRotationTime.Time
+	}
+
+	next := schedule.Next(since)
+	if next.After(now) {
+		changed := status.NextRotationTime == nil || !status.NextRotationTime.Time.Equal(next)
+		status.NextRotationTime = &metav1.Time{Time: next}
+
+		return changed, nil
+	}
+
+	if err = j.rotateAdminCredentials(instance, adminTokenSecretName, now.Add(gracePeriod)); err != nil {
+		return false, err
+	}
+
+	status.LastRotationTime = &metav1.Time{Time: now}
+	status.NextRotationTime = &metav1.Time{Time: schedule.Next(now)}
+
+	return true, nil
+}
+
+// rotateAdminCredentials changes the admin password, issues a new admin token and stores both in the Secrets.
+// The new password is staged in the admin password Secret before it is changed in Jenkins and the same password
+// is applied again if the rotation is repeated. The replaced tokens stay valid until revokeAfter
+// and the tokens issued by the failed rotations are revoked with them, so the rotation is repeated safely.
+func (j JenkinsServiceImpl) rotateAdminCredentials(
+	instance *jenkinsApi.Jenkins,
+	adminTokenSecretName string,
+	revokeAfter time.Time,
+) error {
+	jc, err := j.jenkinsClientFactory.MakeNewClient(&instance.ObjectMeta, &instance.Name)
+	if err != nil {
+		return fmt.Errorf("failed to create Jenkins client: %w", err)
+	}
+
+	user := jenkinsDefaultSpec.JenkinsDefaultAdminUser
+
+	tokens, err := jc.ListAPITokens(user)
+	if err != nil {
+		return fmt.Errorf("failed to list admin tokens: %w", err)
+	}
+
+	adminPasswordSecretName := fmt.Sprintf(configMapStringFormat, instance.Name, jenkinsDefaultSpec.JenkinsAdminPasswordSuffix)
+
+	password, err := j.stageAdminPassword(instance.Namespace, adminPasswordSecretName)
+	if err != nil {
+		return err
+	}
+
+	if err = jc.SaveUser(user, password, "", ""); err != nil {
+		return fmt.Errorf("failed to change admin password: %w", err)
+	}
+
+	token, err := jc.CreateAPIToken(user, adminTokenName)
+	if err != nil {
+		return fmt.Errorf("failed to create admin token: %w", err)
+	}
+
+	if err = j.updateSecretPassword(instance.Namespace, adminTokenSecretName, token.Value); err != nil {
+		return err
+	}
+
+	if err = j.applyStagedAdminPassword(instance.Namespace, adminPasswordSecretName); err != nil {
+		return err
+	}
+
+	status := instance.Status.AdminCredentials
+	status.TokenUUID = token.UUID
+
+	for _, t := range tokens {
+		if t.Name == adminTokenName && t.UUID != token.UUID {
+			status.PendingRevocations = append(status.PendingRevocations, jenkinsApi.PendingTokenRevocation{
+				TokenUUID:   t.UUID,
+				RevokeAfter: metav1.Time{Time: revokeAfter},
+			})
+		}
+	}
+
+	log.Info("admin credentials have been rotated", "jenkins", instance.Name)
+
+	return nil
+}
+
+// revokeReplacedAdminTokens revokes the replaced admin tokens whose grace period is over.
+// The client is created for every call so it authenticates with the latest admin token.
+func (j JenkinsServiceImpl) revokeReplacedAdminTokens(instance *jenkinsApi.Jenkins, now time.Time) (bool, error) {
+	status := instance.Status.AdminCredentials
+
+	var (
+		pending []jenkinsApi.PendingTokenRevocation
+		jc      jenkinsClient.ClientInterface
+	)
+
+	for _, r := range status.PendingRevocations {
+		if r.RevokeAfter.After(now) {
+			pending = append(pending, r)
+
+			continue
+		}
+
+		if jc == nil {
+			var err error
+
+			if jc, err = j.jenkinsClientFactory.MakeNewClient(&instance.ObjectMeta, &instance.Name); err != nil {
+				return false, fmt.Errorf("failed to create Jenkins client: %w", err)
+			}
+		}
+
+		if err := jc.RevokeAPIToken(jenkinsDefaultSpec.JenkinsDefaultAdminUser, r.TokenUUID); err != nil {
+			return false, fmt.Errorf("failed to revoke replaced admin token %s: %w", r.TokenUUID, err)
+		}
+	}
+
+	if len(pending) == len(status.PendingRevocations) {
+		return false, nil
+	}
+
+	status.PendingRevocations = pending
+
+	return true, nil
+}
+
+// stageAdminPassword returns the password staged by the failed rotation or stages a new one in the Secret.
+func (j JenkinsServiceImpl) stageAdminPassword(namespace, name string) (string, error) {
+	secret, err := j.getSecret(namespace, name)
+	if err != nil {
+		return "", err
+	}
+
+	if password, ok := secret.Data[stagedPasswordKey]; ok {
+		return string(password), nil
+	}
+
+	password := uniuri.New()
+	secret.Data[stagedPasswordKey] = []byte(password)
+
+	if err = j.k8sClient.Update(context.TODO(), secret); err != nil {
+		return "", fmt.Errorf("failed to update secret %s: %w", name, err)
+	}
+
+	return password, nil
+}
+
+// applyStagedAdminPassword replaces the password in the Secret with the staged one.
+func (j JenkinsServiceImpl) applyStagedAdminPassword(namespace, name string) error {
+	secret, err := j.getSecret(namespace, name)
+	if err != nil {
+		return err
+	}
+
+	password, ok := secret.Data[stagedPasswordKey]
+	if !ok {
+		return nil
+	}
+
+	secret.Data["password"] = password
+	delete(secret.Data, stagedPasswordKey)
+
+	if err = j.k8sClient.Update(context.TODO(), secret); err != nil {
+		return fmt.Errorf("failed to update secret %s: %w", name, err)
+	}
+
+	return nil
+}
+
+func (j JenkinsServiceImpl) updateSecretPassword(namespace, name, password string) error {
+	secret, err := j.getSecret(namespace, name)
+	if err != nil {
+		return err
+	}
+
+	secret.Data["password"] = []byte(password)
+
+	if err = j.k8sClient.Update(context.TODO(), secret); err != nil {
+		return fmt.Errorf("failed to update secret %s: %w", name, err)
+	}
+
+	return nil
+}
+
+func (j JenkinsServiceImpl) getSecret(namespace, name string) (*coreV1Api.Secret, error) {
+	secret := &coreV1Api.Secret{}
+
+	if err := j.k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, secret); err != nil {
+		return nil, fmt.Errorf("failed to get secret %s: %w", name, err)
+	}
+
+	if secret.Data == nil {
+		secret.Data = make(map[string][]byte)
+	}
+
+	return secret, nil
+}
@@ -0,0 +1,209 @@
+package jenkins
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	coreV1Api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+	jenkinsClient "github.com/epam/edp-jenkins-operator/v2/pkg/client/jenkins"
+)
+
+func newRotationTestInstance() *jenkinsApi.Jenkins {
+	instance := &jenkinsApi.Jenkins{ObjectMeta: ObjectMeta()}
+	instance.CreationTimestamp = metav1.Time{Time: time.Now().Add(-48 * time.Hour)}
+	instance.Status.AdminSecretName = name + "-admin-token"
+	instance.Spec.AdminCredentialsRotation = &jenkinsApi.AdminCredentialsRotation{
+		Schedule:         "0 3 * * *",
+		TokenGracePeriod: "30m",
+	}
+
+	return instance
+}
+
+func newRotationTestService(t *testing.T, jc *jenkinsClient.ClientMock) (JenkinsServiceImpl, client.Client) {
+	t.Helper()
+
+	s := runtime.NewScheme()
+	require.NoError(t, coreV1Api.AddToScheme(s))
+
+	k8sClient := fake.NewClientBuilder().WithScheme(s).WithObjects(
+		&coreV1Api.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: name + "-admin-token", Namespace: namespace},
+			Data:       map[string][]byte{"username": []byte("admin"), "password": []byte("old-token")},
+		},
+		&coreV1Api.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: name + "-admin-password", Namespace: namespace},
+			Data:       map[string][]byte{"username": []byte("admin"), "password": []byte("old-password")},
+		},
+	).Build()
+
+	builder := jenkinsClient.ClientBuilderMock{}
+	builder.On("MakeNewClient", mock.Anything).Return(jc, nil)
+
+	return JenkinsServiceImpl{k8sClient: k8sClient, jenkinsClientFactory: &builder}, k8sClient
+}
+
+func getSecretPassword(t *testing.T, k8sClient client.Client, secretName string) string {
+	t.Helper()
+
+	return string(getSecretData(t, k8sClient, secretName)["password"])
+}
+
+func getSecretData(t *testing.T, k8sClient client.Client, secretName string) map[string][]byte {
+	t.Helper()
+
+	var secret coreV1Api.Secret
+
+	require.NoError(t, k8sClient.Get(context.Background(),
+		types.NamespacedName{Namespace: namespace, Name: secretName}, &secret))
+
+	return secret.Data
+}
+
+func TestJenkinsServiceImpl_RotateAdminCredentials(t *testing.T) {
+	instance := newRotationTestInstance()
+
+	jc := jenkinsClient.ClientMock{}
+	jc.On("ListAPITokens", "admin").Return([]jenkinsClient.APIToken{
+		{UUID: "old", Name: "admin"},
+		{UUID: "ci", Name: "ci"},
+	}, nil)
+	jc.On("SaveUser", "admin", mock.AnythingOfType("string"), "", "").Return(nil)
+	jc.On("CreateAPIToken", "admin", "admin").
+		Return(&jenkinsClient.APIToken{UUID: "new", Name: "admin", Value: "new-token"}, nil)
+
+	svc, k8sClient := newRotationTestService(t, &jc)
+
+	_, updated, err := svc.RotateAdminCredentials(instance)
+	require.NoError(t, err)
+	assert.True(t, updated)
+	jc.AssertExpectations(t)
+
+	status := instance.Status.AdminCredentials
+	assert.Equal(t, "new", status.TokenUUID)
+	assert.NotNil(t, status.LastRotationTime)
+	assert.True(t, status.NextRotationTime.After(time.Now()))
+	require.Len(t, status.PendingRevocations, 1)
+	assert.Equal(t, "old", status.PendingRevocations[0].TokenUUID)
+	assert.WithinDuration(t, time.Now().Add(30*time.Minute), status.PendingRevocations[0].RevokeAfter.Time, time.Minute)
+
+	assert.Equal(t, "new-token", getSecretPassword(t, k8sClient, name+"-admin-token"))
+
+	password := getSecretPassword(t, k8sClient, name+"-admin-password")
+	assert.NotEqual(t, "old-password", password)
+	assert.NotContains(t, getSecretData(t, k8sClient, name+"-admin-password"), stagedPasswordKey)
+	jc.AssertCalled(t, "SaveUser", "admin", password, "", "")
+
+	// the credentials are not rotated again until the next scheduled time.
+	_, updated, err = svc.RotateAdminCredentials(instance)
+	require.NoError(t, err)
+	assert.False(t, updated)
+	jc.AssertNumberOfCalls(t, "CreateAPIToken", 1)
+}
+
+func TestJenkinsServiceImpl_RotateAdminCredentials_Resumed(t *testing.T) {
+	instance := newRotationTestInstance()
+
+	jc := jenkinsClient.ClientMock{}
+	jc.On("ListAPITokens", "admin").Return([]jenkinsClient.APIToken{{UUID: "old", Name: "admin"}}, nil).Once()
+	jc.On("SaveUser", "admin", mock.AnythingOfType("string"), "", "").Return(nil)
+	jc.On("CreateAPIToken", "admin", "admin").Return(nil, errors.New("create fatal")).Once()
+
+	svc, k8sClient := newRotationTestService(t, &jc)
+
+	_, _, err := svc.RotateAdminCredentials(instance)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "create fatal")
+
+	// the password changed in Jenkins is staged in the Secret.
+	staged := string(getSecretData(t, k8sClient, name+"-admin-password")[stagedPasswordKey])
+	require.NotEmpty(t, staged)
+	assert.Equal(t, "old-password", getSecretPassword(t, k8sClient, name+"-admin-password"))
+	jc.AssertCalled(t, "SaveUser", "admin", staged, "", "")
+
+	jc.On("ListAPITokens", "admin").Return([]jenkinsClient.APIToken{{UUID: "old", Name: "admin"}}, nil).Once()
+	jc.On("CreateAPIToken", "admin", "admin").
+		Return(&jenkinsClient.APIToken{UUID: "new", Name: "admin", Value: "new-token"}, nil).Once()
+
+	_, updated, err := svc.RotateAdminCredentials(instance)
+	require.NoError(t, err)
+	assert.True(t, updated)
+	jc.AssertExpectations(t)
+
+	// the repeated rotation applies the staged password.
+	jc.AssertNumberOfCalls(t, "SaveUser", 2)
+	jc.AssertCalled(t, "SaveUser", "admin", staged, "", "")
+	assert.Equal(t, staged, getSecretPassword(t, k8sClient, name+"-admin-password"))
+	assert.NotContains(t, getSecretData(t, k8sClient, name+"-admin-password"), stagedPasswordKey)
+	assert.Equal(t, "new-token", getSecretPassword(t, k8sClient, name+"-admin-token"))
+}
+
+func TestJenkinsServiceImpl_RotateAdminCredentials_NotDue(t *testing.T) {
+	instance := newRotationTestInstance()
+	instance.CreationTimestamp = metav1.Time{Time: time.Now()}
+
+	jc := jenkinsClient.ClientMock{}
+	svc, _ := newRotationTestService(t, &jc)
+
+	_, updated, err := svc.RotateAdminCredentials(instance)
+	require.NoError(t, err)
+	assert.True(t, updated)
+	assert.NotNil(t, instance.Status.AdminCredentials.NextRotationTime)
+	assert.Nil(t, instance.Status.AdminCredentials.LastRotationTime)
+	jc.AssertNotCalled(t, "CreateAPIToken", "admin", "admin")
+}
+
+func TestJenkinsServiceImpl_RotateAdminCredentials_RevokeReplacedTokens(t *testing.T) {
+	instance := newRotationTestInstance()
+	instance.Spec.AdminCredentialsRotation = nil
+	instance.Status.AdminCredentials = &jenkinsApi.AdminCredentialsStatus{
+		PendingRevocations: []jenkinsApi.PendingTokenRevocation{
+			{TokenUUID: "expired", RevokeAfter: metav1.Time{Time: time.Now().Add(-time.Minute)}},
+			{TokenUUID: "valid", RevokeAfter: metav1.Time{Time: time.Now().Add(time.Hour)}},
+		},
+	}
+
+	jc := jenkinsClient.ClientMock{}
+	jc.On("RevokeAPIToken", "admin", "expired").Return(nil)
+
+	svc, _ := newRotationTestService(t, &jc)
+
+	_, updated, err := svc.RotateAdminCredentials(instance)
+	require.NoError(t, err)
+	assert.True(t, updated)
+	jc.AssertExpectations(t)
+	require.Len(t, instance.Status.AdminCredentials.PendingRevocations, 1)
+	assert.Equal(t, "valid", instance.Status.AdminCredentials.PendingRevocations[0].TokenUUID)
+}
+
+func TestJenkinsServiceImpl_RotateAdminCredentials_Skipped(t *testing.T) {
+	instance := newRotationTestInstance()
+	instance.Status.AdminSecretName = name + "-admin-password"
+
+	jc := jenkinsClient.ClientMock{}
+	svc, _ := newRotationTestService(t, &jc)
+
+	_, updated, err := svc.RotateAdminCredentials(instance)
+	require.NoError(t, err)
+	assert.False(t, updated)
+	assert.Nil(t, instance.Status.AdminCredentials)
+
+	instance.Status.AdminSecretName = name + "-admin-token"
+	instance.Spec.AdminCredentialsRotation.Schedule = "weekly"
+
+	_, _, err = svc.RotateAdminCredentials(instance)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to parse admin credentials rotation schedule")
+}
@@ -28,9 +28,11 @@ import (
 	jenkinsClient "github.com/epam/edp-jenkins-operator/v2/pkg/client/jenkins"
 	helperController "github.com/epam/edp-jenkins-operator/v2/pkg/controller/helper"
 	"github.com/epam/edp-jenkins-operator/v2/pkg/helper"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/service/buildevents"
 	jenkinsDefaultSpec "github.com/epam/edp-jenkins-operator/v2/pkg/service/jenkins/spec"
 	"github.com/epam/edp-jenkins-operator/v2/pkg/service/platform"
 	platformHelper "github.com/epam/edp-jenkins-operator/v2/pkg/service/platform/helper"
+	"github.com/epam/edp-jenkins-operator/v2/pkg/util"
 	"github.com/epam/edp-jenkins-operator/v2/pkg/util/consts"
 )
 
@@ -46,11 +48,14 @@ const (
 	SharedLibrariesTemplateName     = "config-shared-libraries.tmpl"
 	kubernetesPluginTemplateName    = "config-kubernetes-plugin.tmpl"
 	keycloakConfigTemplateName      = "config-keycloak.tmpl"
+	buildEventsTemplateName         = "config-build-events.tmpl"
 	cbisTemplateName                = "cbis.json"
 	jimTemplateName                 = "jim.json"
 	defaultScriptConfigMapKey       = "context"
 	sshKeyDefaultMountPath          = "/tmp/ssh"
 
+	buildEventsTokenLength = 32
+
 	imgFolder = "img"
 	jenIcon   = "jenkins.svg"
 
@@ -67,15 +72,19 @@ type JenkinsService interface {
 	Integration(instance *jenkinsApi.Jenkins) (*jenkinsApi.Jenkins, bool, error)
 	IsDeploymentReady(instance *jenkinsApi.Jenkins) (bool, error)
 	CreateAdminPassword(instance *jenkinsApi.Jenkins) error
+	RotateAdminCredentials(instance *jenkinsApi.Jenkins) (*jenkinsApi.Jenkins, bool, error)
+	SyncPlugins(instance *jenkinsApi.Jenkins) (*jenkinsApi.Jenkins, bool, error)
+	SyncKubernetesClouds(instance *jenkinsApi.Jenkins) (*jenkinsApi.Jenkins, bool, error)
 }
 
 // NewJenkinsService function that returns JenkinsService implementation.
 func NewJenkinsService(ps platform.PlatformService, k8sClient client.Client, scheme *runtime.Scheme) JenkinsService {
 	return JenkinsServiceImpl{
-		platformService: ps,
-		k8sClient:       k8sClient,
-		k8sScheme:       scheme,
-		keycloakHelper:  keycloakControllerHelper.MakeHelper(k8sClient, scheme, ctrl.Log.WithName("jenkins_service")),
+		platformService:      ps,
+		k8sClient:            k8sClient,
+		k8sScheme:            scheme,
+		keycloakHelper:       keycloakControllerHelper.MakeHelper(k8sClient, scheme, ctrl.Log.WithName("jenkins_service")),
+		jenkinsClientFactory: jenkinsClient.MakeClientBuilder(ps, k8sClient),
 	}
 }
 
@@ -85,6 +94,8 @@ type JenkinsServiceImpl struct {
 	k8sClient       client.Client
 	k8sScheme       *runtime.Scheme
 	keycloakHelper  *keycloakControllerHelper.Helper
+	// jenkinsClientFactory creates clients authenticated with the current admin credentials.
+	jenkinsClientFactory jenkinsClient.ClientFactory
 }
 
 func (j JenkinsServiceImpl) setAdminSecretInStatus(instance *jenkinsApi.Jenkins, value string) (*jenkinsApi.Jenkins, error) {
@@ -310,6 +321,12 @@ func setAnnotation(instance *jenkinsApi.Jenkins, key, value string) {
 
 // Integration performs Jenkins integration with other EDP components.
 func (j JenkinsServiceImpl) Integration(instance *jenkinsApi.Jenkins) (*jenkinsApi.Jenkins, bool, error) {
+	if instance.Spec.SecurityRealm != nil {
+		if err := j.configureSecurityRealm(instance); err != nil {
+			return instance, false, fmt.Errorf("failed to configure security realm: %w", err)
+		}
+	}
+
 	if instance.Spec.KeycloakSpec.Enabled {
 		enabledInstance, err := j.integrateEnabledInstance(instance)
 		if err != nil {
@@ -374,6 +391,10 @@ func (j JenkinsServiceImpl) integrateEnabledInstance(instance *jenkinsApi.Jenkin
 		return instance, fmt.Errorf("failed to create jenkins script: %w", err)
 	}
 
+	if err = j.syncKeycloakRoleMappings(instance); err != nil {
+		return instance, fmt.Errorf("failed to sync keycloak role mappings: %w", err)
+	}
+
 	return instance, nil
 }
 
@@ -607,17 +628,16 @@ func (j JenkinsServiceImpl) createTemplatesFromDefaultDir(instance *jenkinsApi.J
 		return fmt.Errorf("failed to create path to template dir: %w", err)
 	}
 
-	var templatesList []string
+	templatesList := []string{SharedLibrariesTemplateName}
 
-	templatesList = append(
-		templatesList,
-		SharedLibrariesTemplateName,
-		kubernetesPluginTemplateName,
-	)
+	// the default cloud is configured by SyncKubernetesClouds instead of the template if it is listed in the spec.
+	if !hasKubernetesCloud(instance, defaultCloudName) {
+		templatesList = append(templatesList, kubernetesPluginTemplateName)
+	}
 
 	jenkinsScriptData := platformHelper.JenkinsScriptData{}
 	jenkinsScriptData.JenkinsSharedLibraries = instance.Spec.SharedLibraries
-	jenkinsScriptData.JenkinsUrl = fmt.Sprintf("http://%v:%v/%v", instance.Name, jenkinsDefaultSpec.JenkinsDefaultUiPort, instance.Spec.BasePath)
+	jenkinsScriptData.JenkinsUrl = jenkinsServiceURL(instance)
 
 	for _, template := range templatesList {
 		if err = createTemplateScript(
@@ -679,9 +699,48 @@ func (j JenkinsServiceImpl) Configure(instance *jenkinsApi.Jenkins) (*jenkinsApi
 		return instance, false, fmt.Errorf("failed to create Templates from Default Dir: %w", err)
 	}
 
+	if err = j.configureBuildEvents(instance); err != nil {
+		return instance, false, fmt.Errorf("failed to configure build events: %w", err)
+	}
+
 	return instance, true, nil
 }
 
+// configureBuildEvents installs the Jenkins listener which pushes build events to the operator.
+// It does nothing if the operator build events endpoint is not configured.
+func (j JenkinsServiceImpl) configureBuildEvents(instance *jenkinsApi.Jenkins) error {
+	eventsURL := util.GetBuildEventsURL()
+	if eventsURL == "" {
+		return nil
+	}
+
+	secretName := fmt.Sprintf(configMapStringFormat, instance.Name, buildevents.TokenSecretSuffix)
+
+	if err := j.platformService.CreateSecret(instance, secretName, map[string][]byte{
+		buildevents.TokenSecretKey: []byte(uniuri.NewLen(buildEventsTokenLength)),
+	}); err != nil {
+		return fmt.Errorf("failed to create secret %s: %w", secretName, err)
+	}
+
+	secretData, err := j.platformService.GetSecretData(instance.Namespace, secretName)
+	if err != nil {
+		return fmt.Errorf("failed to get secret %s: %w", secretName, err)
+	}
+
+	templatesDirectoryPath, err := platformHelper.CreatePathToTemplateDirectory(DefaultTemplatesDirectory)
+	if err != nil {
+		return fmt.Errorf("failed to create path to template dir: %w", err)
+	}
+
+	jenkinsScriptData := platformHelper.JenkinsScriptData{
+		BuildEventsUrl: fmt.Sprintf("%s%s%s/%s",
+			strings.TrimSuffix(eventsURL, "/"), buildevents.Path, instance.Namespace, instance.Name),
+		BuildEventsToken: string(secretData[buildevents.TokenSecretKey]),
+	}
+
+	return createTemplateScript(templatesDirectoryPath, buildEventsTemplateName, j.platformService, &jenkinsScriptData, instance)
+}
+
 func createTemplateScript(
 	templatesDirectoryPath, template string,
 	platformService platform.PlatformService,
@@ -0,0 +1,148 @@
+package jenkins
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"reflect"
+	"regexp"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+	helperController "github.com/epam/edp-jenkins-operator/v2/pkg/controller/helper"
+)
+
+// keycloakRoleMappingLabel marks JenkinsAuthorizationRoleMappings generated from spec.keycloakSpec.roleMappings,
+// its value is the name of the Jenkins.
+const keycloakRoleMappingLabel = "edp.epam.com/keycloak-role-mapping"
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// syncKeycloakRoleMappings keeps JenkinsAuthorizationRoleMappings in line with spec.keycloakSpec.roleMappings.
+// Removed mappings are deleted, so their roles are unassigned by the JenkinsAuthorizationRoleMapping finalizer.
+func (j JenkinsServiceImpl) syncKeycloakRoleMappings(instance *jenkinsApi.Jenkins) error {
+	desired := make(map[string]*jenkinsApi.JenkinsAuthorizationRoleMapping)
+
+	for _, m := range instance.Spec.KeycloakSpec.RoleMappings {
+		rm, err := newKeycloakRoleMapping(instance, m)
+		if err != nil {
+			return err
+		}
+
+		// the entries for the same subject and role type share the name, their roles are merged.
+		if prev, ok := desired[rm.Name]; ok {
+			rm.Spec.Roles = mergeRoles(prev.Spec.Roles, rm.Spec.Roles)
+		}
+
+		desired[rm.Name] = rm
+	}
+
+	existing := &jenkinsApi.JenkinsAuthorizationRoleMappingList{}
+	if err := j.k8sClient.List(context.TODO(), existing, client.InNamespace(instance.Namespace),
+		client.MatchingLabels{keycloakRoleMappingLabel: instance.Name}); err != nil {
+		return fmt.Errorf("failed to list keycloak role mappings: %w", err)
+	}
+
+	for i := range existing.Items {
+		current := &existing.Items[i]
+
+		want, ok := desired[current.Name]
+		if !ok {
+			if err := j.k8sClient.Delete(context.TODO(), current); client.IgnoreNotFound(err) != nil {
+				return fmt.Errorf("failed to delete keycloak role mapping %s: %w", current.Name, err)
+			}
+
+			log.Info("keycloak role mapping has been removed", "name", current.Name)
+
+			continue
+		}
+
+		delete(desired, current.Name)
+
+		if reflect.DeepEqual(current.Spec, want.Spec) {
+			continue
+		}
+
+		current.Spec = want.Spec
+		if err := j.k8sClient.Update(context.TODO(), current); err != nil {
+			return fmt.Errorf("failed to update keycloak role mapping %s: %w", current.Name, err)
+		}
+	}
+
+	for _, rm := range desired {
+		if err := controllerutil.SetControllerReference(instance, rm, j.k8sScheme); err != nil {
+			return fmt.Errorf("failed to set owner reference for keycloak role mapping %s: %w", rm.Name, err)
+		}
+
+		if err := j.k8sClient.Create(context.TODO(), rm); err != nil {
+			return fmt.Errorf("failed to create keycloak role mapping %s: %w", rm.Name, err)
+		}
+
+		log.Info("keycloak role mapping has been created", "name", rm.Name)
+	}
+
+	return nil
+}
+
+// mergeRoles appends the roles which are not listed yet.
+func mergeRoles(roles, added []string) []string {
+	merged := append([]string{}, roles...)
+
+	for _, r := range added {
+		if !helperController.ContainsString(merged, r) {
+			merged = append(merged, r)
+		}
+	}
+
+	return merged
+}
+
+func newKeycloakRoleMapping(
+	instance *jenkinsApi.Jenkins,
+	m jenkinsApi.KeycloakRoleMapping,
+) (*jenkinsApi.JenkinsAuthorizationRoleMapping, error) {
+	kind, subject := "group", m.Group
+	if m.RealmRole != "" {
+		kind, subject = "role", m.RealmRole
+	}
+
+	if subject == "" || (m.Group != "" && m.RealmRole != "") {
+		return nil, errors.New("exactly one of group and realmRole must be set in keycloak role mapping")
+	}
+
+	owner := instance.Name
+
+	return &jenkinsApi.JenkinsAuthorizationRoleMapping{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      keycloakRoleMappingName(instance.Name, kind, subject, m.RoleType),
+			Namespace: instance.Namespace,
+			Labels:    map[string]string{keycloakRoleMappingLabel: instance.Name},
+		},
+		Spec: jenkinsApi.JenkinsAuthorizationRoleMappingSpec{
+			OwnerName: &owner,
+			Group:     subject,
+			RoleType:  m.RoleType,
+			Roles:     m.Roles,
+		},
+	}, nil
+}
+
+// keycloakRoleMappingName returns a valid object name, the hash keeps it unique for subjects differing in invalid chars.
+func keycloakRoleMappingName(jenkinsName, kind, subject, roleType string) string {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(kind + "/" + subject + "/" + roleType))
+
+	sanitized := strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(subject), "-"), "-")
+
+	const maxSubjectLen = 40
+	if len(sanitized) > maxSubjectLen {
+		sanitized = sanitized[:maxSubjectLen]
+	}
+
+	return fmt.Sprintf("%s-keycloak-%s-%s-%08x", jenkinsName, kind, sanitized, h.Sum32())
+}
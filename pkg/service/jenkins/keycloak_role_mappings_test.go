@@ -0,0 +1,116 @@
+package jenkins
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+)
+
+func TestJenkinsServiceImpl_syncKeycloakRoleMappings(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, jenkinsApi.AddToScheme(scheme))
+
+	instance := &jenkinsApi.Jenkins{ObjectMeta: ObjectMeta()}
+	instance.Spec.KeycloakSpec.RoleMappings = []jenkinsApi.KeycloakRoleMapping{
+		{Group: "Team A", RoleType: "projectRoles", Roles: []string{"developer"}},
+		{RealmRole: "jenkins-administrators", RoleType: "globalRoles", Roles: []string{"administrator"}},
+	}
+
+	kept := keycloakRoleMappingName(name, "group", "Team A", "projectRoles")
+	stale := &jenkinsApi.JenkinsAuthorizationRoleMapping{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "stale",
+			Namespace: namespace,
+			Labels:    map[string]string{keycloakRoleMappingLabel: name},
+		},
+	}
+	outdated := &jenkinsApi.JenkinsAuthorizationRoleMapping{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      kept,
+			Namespace: namespace,
+			Labels:    map[string]string{keycloakRoleMappingLabel: name},
+		},
+		Spec: jenkinsApi.JenkinsAuthorizationRoleMappingSpec{Group: "Team A", RoleType: "projectRoles"},
+	}
+	foreign := &jenkinsApi.JenkinsAuthorizationRoleMapping{
+		ObjectMeta: v1.ObjectMeta{Name: "manual", Namespace: namespace},
+	}
+
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(instance, stale, outdated, foreign).Build()
+
+	impl := JenkinsServiceImpl{
+		k8sClient: k8sClient,
+		k8sScheme: scheme,
+	}
+
+	require.NoError(t, impl.syncKeycloakRoleMappings(instance))
+
+	var list jenkinsApi.JenkinsAuthorizationRoleMappingList
+	require.NoError(t, k8sClient.List(context.Background(), &list, client.InNamespace(namespace)))
+
+	mappings := make(map[string]jenkinsApi.JenkinsAuthorizationRoleMapping)
+	for _, m := range list.Items {
+		mappings[m.Name] = m
+	}
+
+	require.Len(t, mappings, 3)
+	require.Contains(t, mappings, "manual")
+	require.NotContains(t, mappings, "stale")
+	require.Equal(t, []string{"developer"}, mappings[kept].Spec.Roles)
+
+	admins := mappings[keycloakRoleMappingName(name, "role", "jenkins-administrators", "globalRoles")]
+	require.Equal(t, "jenkins-administrators", admins.Spec.Group)
+	require.Equal(t, name, *admins.Spec.OwnerName)
+	require.Len(t, admins.OwnerReferences, 1)
+}
+
+func TestJenkinsServiceImpl_syncKeycloakRoleMappings_Duplicates(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, jenkinsApi.AddToScheme(scheme))
+
+	instance := &jenkinsApi.Jenkins{ObjectMeta: ObjectMeta()}
+	instance.Spec.KeycloakSpec.RoleMappings = []jenkinsApi.KeycloakRoleMapping{
+		{Group: "devs", RoleType: "globalRoles", Roles: []string{"developer", "viewer"}},
+		{Group: "devs", RoleType: "globalRoles", Roles: []string{"viewer", "deployer"}},
+	}
+
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(instance).Build()
+
+	impl := JenkinsServiceImpl{
+		k8sClient: k8sClient,
+		k8sScheme: scheme,
+	}
+
+	require.NoError(t, impl.syncKeycloakRoleMappings(instance))
+
+	var list jenkinsApi.JenkinsAuthorizationRoleMappingList
+	require.NoError(t, k8sClient.List(context.Background(), &list, client.InNamespace(namespace)))
+	require.Len(t, list.Items, 1)
+	require.Equal(t, []string{"developer", "viewer", "deployer"}, list.Items[0].Spec.Roles)
+	require.Equal(t, []string{"developer", "viewer"}, instance.Spec.KeycloakSpec.RoleMappings[0].Roles)
+}
+
+func TestJenkinsServiceImpl_syncKeycloakRoleMappings_Invalid(t *testing.T) {
+	instance := &jenkinsApi.Jenkins{ObjectMeta: ObjectMeta()}
+	instance.Spec.KeycloakSpec.RoleMappings = []jenkinsApi.KeycloakRoleMapping{
+		{Group: "a", RealmRole: "b", RoleType: "globalRoles"},
+	}
+
+	err := JenkinsServiceImpl{}.syncKeycloakRoleMappings(instance)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "exactly one of group and realmRole")
+}
+
+func TestKeycloakRoleMappingName(t *testing.T) {
+	n := keycloakRoleMappingName("jenkins", "group", "/Team A/Devs", "projectRoles")
+
+	require.Regexp(t, `^jenkins-keycloak-group-team-a-devs-[0-9a-f]{8}$`, n)
+	require.NotEqual(t, n, keycloakRoleMappingName("jenkins", "group", "/Team A/Devs", "globalRoles"))
+}
@@ -0,0 +1,112 @@
+package jenkins
+
+import (
+	"fmt"
+	"reflect"
+
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+	jenkinsClient "github.com/epam/edp-jenkins-operator/v2/pkg/client/jenkins"
+	helperController "github.com/epam/edp-jenkins-operator/v2/pkg/controller/helper"
+	jenkinsDefaultSpec "github.com/epam/edp-jenkins-operator/v2/pkg/service/jenkins/spec"
+)
+
+const (
+	defaultCloudContainerCap   = 50
+	defaultCloudPodRetention   = "Never"
+	defaultCloudConnectTimeout = 5
+	defaultCloudReadTimeout    = 15
+
+	// defaultCloudName is the cloud configured by the default template, the agent templates are added to it.
+	defaultCloudName = "openshift"
+)
+
+// SyncKubernetesClouds applies spec.kubernetesClouds on every reconciliation, so changes made in Jenkins are reverted,
+// and deletes the clouds which are no longer listed. The default cloud is adopted when it is listed and is kept
+// with its last settings when it is removed from the list. It returns true if the instance status has been changed.
+func (j JenkinsServiceImpl) SyncKubernetesClouds(instance *jenkinsApi.Jenkins) (*jenkinsApi.Jenkins, bool, error) {
+	clouds := instance.Spec.KubernetesClouds
+	if len(clouds) == 0 && len(instance.Status.KubernetesClouds) == 0 {
+		return instance, false, nil
+	}
+
+	jc, err := j.jenkinsClientFactory.MakeNewClient(&instance.ObjectMeta, &instance.Name)
+	if err != nil {
+		return instance, false, fmt.Errorf("failed to create Jenkins client: %w", err)
+	}
+
+	var managed []string
+
+	for i := range clouds {
+		changed, err := jc.SaveKubernetesCloud(newKubernetesCloud(instance, &clouds[i]))
+		if err != nil {
+			return instance, false, fmt.Errorf("failed to save kubernetes cloud %s: %w", clouds[i].Name, err)
+		}
+
+		if changed {
+			log.Info("kubernetes cloud has been configured", "jenkins", instance.Name, "cloud", clouds[i].Name)
+		}
+
+		managed = append(managed, clouds[i].Name)
+	}
+
+	for _, name := range instance.Status.KubernetesClouds {
+		if name == defaultCloudName || helperController.ContainsString(managed, name) {
+			continue
+		}
+
+		if err = jc.DeleteKubernetesCloud(name); err != nil {
+			return instance, false, fmt.Errorf("failed to delete kubernetes cloud %s: %w", name, err)
+		}
+
+		log.Info("kubernetes cloud has been deleted", "jenkins", instance.Name, "cloud", name)
+	}
+
+	if reflect.DeepEqual(instance.Status.KubernetesClouds, managed) {
+		return instance, false, nil
+	}
+
+	instance.Status.KubernetesClouds = managed
+
+	return instance, true, nil
+}
+
+// newKubernetesCloud returns the cloud settings with the defaults applied.
+func newKubernetesCloud(instance *jenkinsApi.Jenkins, cloud *jenkinsApi.KubernetesCloud) *jenkinsClient.KubernetesCloud {
+	return &jenkinsClient.KubernetesCloud{
+		Name:           cloud.Name,
+		ServerURL:      cloud.ServerURL,
+		Namespace:      helperController.ValueOrDefault(cloud.Namespace, instance.Namespace),
+		CredentialsID:  cloud.CredentialsID,
+		SkipTLSVerify:  cloud.SkipTLSVerify,
+		JenkinsURL:     helperController.ValueOrDefault(cloud.JenkinsURL, jenkinsServiceURL(instance)),
+		JenkinsTunnel:  cloud.JenkinsTunnel,
+		WebSocket:      cloud.WebSocket,
+		ContainerCap:   int32OrDefault(cloud.ContainerCap, defaultCloudContainerCap),
+		PodRetention:   helperController.ValueOrDefault(cloud.PodRetention, defaultCloudPodRetention),
+		ConnectTimeout: int32OrDefault(cloud.ConnectTimeout, defaultCloudConnectTimeout),
+		ReadTimeout:    int32OrDefault(cloud.ReadTimeout, defaultCloudReadTimeout),
+	}
+}
+
+func hasKubernetesCloud(instance *jenkinsApi.Jenkins, name string) bool {
+	for i := range instance.Spec.KubernetesClouds {
+		if instance.Spec.KubernetesClouds[i].Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// jenkinsServiceURL is the in-cluster Jenkins URL.
+func jenkinsServiceURL(instance *jenkinsApi.Jenkins) string {
+	return fmt.Sprintf("http://%v:%v/%v", instance.Name, jenkinsDefaultSpec.JenkinsDefaultUiPort, instance.Spec.BasePath)
+}
+
+func int32OrDefault(value *int32, defaultValue int32) int32 {
+	if value == nil {
+		return defaultValue
+	}
+
+	return *value
+}
@@ -0,0 +1,132 @@
+package jenkins
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+	jenkinsClient "github.com/epam/edp-jenkins-operator/v2/pkg/client/jenkins"
+)
+
+func TestJenkinsServiceImpl_SyncKubernetesClouds(t *testing.T) {
+	containerCap := int32(10)
+
+	instance := &jenkinsApi.Jenkins{ObjectMeta: ObjectMeta()}
+	instance.Spec.KubernetesClouds = []jenkinsApi.KubernetesCloud{
+		{Name: "openshift"},
+		{
+			Name:          "remote",
+			ServerURL:     "https://k8s.example.com",
+			Namespace:     "agents",
+			CredentialsID: "remote-token",
+			WebSocket:     true,
+			ContainerCap:  &containerCap,
+			PodRetention:  "OnFailure",
+		},
+	}
+	instance.Status.KubernetesClouds = []string{"openshift", "old"}
+
+	jc := jenkinsClient.ClientMock{}
+	jc.On("SaveKubernetesCloud", &jenkinsClient.KubernetesCloud{
+		Name:           "openshift",
+		Namespace:      namespace,
+		JenkinsURL:     "http://" + name + ":8080/",
+		ContainerCap:   defaultCloudContainerCap,
+		PodRetention:   defaultCloudPodRetention,
+		ConnectTimeout: defaultCloudConnectTimeout,
+		ReadTimeout:    defaultCloudReadTimeout,
+	}).Return(false, nil)
+	jc.On("SaveKubernetesCloud", &jenkinsClient.KubernetesCloud{
+		Name:           "remote",
+		ServerURL:      "https://k8s.example.com",
+		Namespace:      "agents",
+		CredentialsID:  "remote-token",
+		JenkinsURL:     "http://" + name + ":8080/",
+		WebSocket:      true,
+		ContainerCap:   10,
+		PodRetention:   "OnFailure",
+		ConnectTimeout: defaultCloudConnectTimeout,
+		ReadTimeout:    defaultCloudReadTimeout,
+	}).Return(true, nil)
+	jc.On("DeleteKubernetesCloud", "old").Return(nil)
+
+	_, updated, err := newPluginsTestService(&jc).SyncKubernetesClouds(instance)
+	require.NoError(t, err)
+	assert.True(t, updated)
+	jc.AssertExpectations(t)
+	assert.Equal(t, []string{"openshift", "remote"}, instance.Status.KubernetesClouds)
+
+	// the drift is corrected on every call, the status is left unchanged.
+	_, updated, err = newPluginsTestService(&jc).SyncKubernetesClouds(instance)
+	require.NoError(t, err)
+	assert.False(t, updated)
+	jc.AssertNumberOfCalls(t, "SaveKubernetesCloud", 4)
+}
+
+func TestJenkinsServiceImpl_SyncKubernetesClouds_Disabled(t *testing.T) {
+	instance := &jenkinsApi.Jenkins{ObjectMeta: ObjectMeta()}
+
+	_, updated, err := newPluginsTestService(&jenkinsClient.ClientMock{}).SyncKubernetesClouds(instance)
+	require.NoError(t, err)
+	assert.False(t, updated)
+}
+
+func TestJenkinsServiceImpl_SyncKubernetesClouds_SaveErr(t *testing.T) {
+	instance := &jenkinsApi.Jenkins{ObjectMeta: ObjectMeta()}
+	instance.Spec.KubernetesClouds = []jenkinsApi.KubernetesCloud{{Name: "openshift"}}
+
+	jc := jenkinsClient.ClientMock{}
+	jc.On("SaveKubernetesCloud", &jenkinsClient.KubernetesCloud{
+		Name:           "openshift",
+		Namespace:      namespace,
+		JenkinsURL:     "http://" + name + ":8080/",
+		ContainerCap:   defaultCloudContainerCap,
+		PodRetention:   defaultCloudPodRetention,
+		ConnectTimeout: defaultCloudConnectTimeout,
+		ReadTimeout:    defaultCloudReadTimeout,
+	}).Return(false, errors.New("cloud openshift is not a Kubernetes cloud"))
+
+	_, _, err := newPluginsTestService(&jc).SyncKubernetesClouds(instance)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to save kubernetes cloud openshift")
+	assert.Nil(t, instance.Status.KubernetesClouds)
+}
+
+func TestJenkinsServiceImpl_SyncKubernetesClouds_DefaultCloudRemoved(t *testing.T) {
+	instance := &jenkinsApi.Jenkins{ObjectMeta: ObjectMeta()}
+	instance.Spec.KubernetesClouds = []jenkinsApi.KubernetesCloud{{Name: "remote", ServerURL: "https://k8s.example.com"}}
+	instance.Status.KubernetesClouds = []string{"openshift", "remote"}
+
+	jc := jenkinsClient.ClientMock{}
+	jc.On("SaveKubernetesCloud", &jenkinsClient.KubernetesCloud{
+		Name:           "remote",
+		ServerURL:      "https://k8s.example.com",
+		Namespace:      namespace,
+		JenkinsURL:     "http://" + name + ":8080/",
+		ContainerCap:   defaultCloudContainerCap,
+		PodRetention:   defaultCloudPodRetention,
+		ConnectTimeout: defaultCloudConnectTimeout,
+		ReadTimeout:    defaultCloudReadTimeout,
+	}).Return(false, nil)
+
+	_, updated, err := newPluginsTestService(&jc).SyncKubernetesClouds(instance)
+	require.NoError(t, err)
+	assert.True(t, updated)
+	jc.AssertExpectations(t)
+	jc.AssertNotCalled(t, "DeleteKubernetesCloud", "openshift")
+	assert.Equal(t, []string{"remote"}, instance.Status.KubernetesClouds)
+}
+
+func TestHasKubernetesCloud(t *testing.T) {
+	instance := &jenkinsApi.Jenkins{ObjectMeta: ObjectMeta()}
+	assert.False(t, hasKubernetesCloud(instance, defaultCloudName))
+
+	instance.Spec.KubernetesClouds = []jenkinsApi.KubernetesCloud{{Name: "remote"}}
+	assert.False(t, hasKubernetesCloud(instance, defaultCloudName))
+
+	instance.Spec.KubernetesClouds = append(instance.Spec.KubernetesClouds, jenkinsApi.KubernetesCloud{Name: "openshift"})
+	assert.True(t, hasKubernetesCloud(instance, defaultCloudName))
+}
@@ -0,0 +1,262 @@
+package jenkins
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+	jenkinsClient "github.com/epam/edp-jenkins-operator/v2/pkg/client/jenkins"
+	helperController "github.com/epam/edp-jenkins-operator/v2/pkg/controller/helper"
+)
+
+const (
+	defaultPluginsDownloadURL = "https://updates.jenkins.io/download/plugins"
+
+	// restartInterval prevents scheduling a restart again while Jenkins waits for running builds.
+	restartInterval = 15 * time.Minute
+)
+
+// SyncPlugins installs, pins and uninstalls the managed plugins and restarts Jenkins to complete the changes
+// according to the restart policy. The synchronization errors are reported in the plugins status,
+// so they do not block the rest of the reconciliation. It returns true if the instance status has been changed.
+func (j JenkinsServiceImpl) SyncPlugins(instance *jenkinsApi.Jenkins) (*jenkinsApi.Jenkins, bool, error) {
+	pm := instance.Spec.PluginManagement
+	if pm == nil && instance.Status.Plugins == nil {
+		return instance, false, nil
+	}
+
+	if pm == nil {
+		pm = &jenkinsApi.PluginManagement{}
+	}
+
+	current := &jenkinsApi.PluginsStatus{}
+	if instance.Status.Plugins != nil {
+		current = instance.Status.Plugins
+	}
+
+	status, err := j.syncPlugins(instance, pm, current)
+	if err != nil {
+		log.Error(err, "failed to sync plugins", "jenkins", instance.Name)
+
+		status = current.DeepCopy()
+		status.Error = err.Error()
+	}
+
+	if len(status.Plugins) == 0 && status.Error == "" && instance.Spec.PluginManagement == nil {
+		status = nil
+	}
+
+	if reflect.DeepEqual(instance.Status.Plugins, status) {
+		return instance, false, nil
+	}
+
+	instance.Status.Plugins = status
+
+	return instance, true, nil
+}
+
+func (j JenkinsServiceImpl) syncPlugins(
+	instance *jenkinsApi.Jenkins,
+	pm *jenkinsApi.PluginManagement,
+	current *jenkinsApi.PluginsStatus,
+) (*jenkinsApi.PluginsStatus, error) {
+	jc, err := j.jenkinsClientFactory.MakeNewClient(&instance.ObjectMeta, &instance.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Jenkins client: %w", err)
+	}
+
+	restartRequired, err := jc.IsRestartRequired()
+	if err != nil {
+		return nil, fmt.Errorf("failed to check if restart is required: %w", err)
+	}
+
+	installed, err := installedPlugins(jc)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &jenkinsApi.PluginsStatus{LastRestartTime: current.LastRestartTime}
+
+	plugins, err := syncDesiredPlugins(jc, pm, installed, current, restartRequired)
+	if err != nil {
+		return nil, err
+	}
+
+	removed, err := uninstallRemovedPlugins(jc, pm, installed, current)
+	if err != nil {
+		return nil, err
+	}
+
+	status.Plugins = append(plugins, removed...)
+
+	if restartRequired, err = jc.IsRestartRequired(); err != nil {
+		return nil, fmt.Errorf("failed to check if restart is required: %w", err)
+	}
+
+	// only the changes of the managed plugins are completed, a restart required by anything else is left to the users.
+	// uninstalled plugins are removed on restart, but the update center does not report it.
+	status.RestartRequired = hasPendingPlugins(status) && (restartRequired || len(removed) > 0)
+
+	if err = j.restartIfRequired(jc, instance, pm, status); err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
+
+func installedPlugins(jc jenkinsClient.ClientInterface) (map[string]jenkinsClient.Plugin, error) {
+	plugins, err := jc.GetPlugins()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get installed plugins: %w", err)
+	}
+
+	installed := make(map[string]jenkinsClient.Plugin, len(plugins))
+	for _, p := range plugins {
+		installed[p.ShortName] = p
+	}
+
+	return installed, nil
+}
+
+// syncDesiredPlugins installs the missing plugins and the pinned versions. The changes which are already
+// waiting for a restart are not repeated.
+func syncDesiredPlugins(
+	jc jenkinsClient.ClientInterface,
+	pm *jenkinsApi.PluginManagement,
+	installed map[string]jenkinsClient.Plugin,
+	current *jenkinsApi.PluginsStatus,
+	restartRequired bool,
+) ([]jenkinsApi.PluginStatus, error) {
+	downloadURL := strings.TrimSuffix(helperController.ValueOrDefault(pm.DownloadURL, defaultPluginsDownloadURL), "/")
+
+	var (
+		statuses []jenkinsApi.PluginStatus
+		latest   []string
+	)
+
+	for _, p := range pm.Plugins {
+		ip, ok := installed[p.Name]
+		st := jenkinsApi.PluginStatus{
+			Name:             p.Name,
+			DesiredVersion:   p.Version,
+			InstalledVersion: ip.Version,
+			State:            jenkinsApi.PluginStatePending,
+		}
+
+		switch {
+		case ok && !ip.Deleted && (p.Version == "" || p.Version == ip.Version):
+			st.State = jenkinsApi.PluginStateInstalled
+		case restartRequired && isPluginPending(current, p):
+		case p.Version == "":
+			latest = append(latest, fmt.Sprintf("%s@latest", p.Name))
+		default:
+			if err := jc.DeployPlugin(fmt.Sprintf("%s/%s/%s/%s.hpi", downloadURL, p.Name, p.Version, p.Name)); err != nil {
+				return nil, fmt.Errorf("failed to deploy plugin %s: %w", p.Name, err)
+			}
+
+			log.Info("plugin version has been deployed", "plugin", p.Name, "version", p.Version)
+		}
+
+		statuses = append(statuses, st)
+	}
+
+	if len(latest) > 0 {
+		if err := jc.InstallPlugins(latest); err != nil {
+			return nil, fmt.Errorf("failed to install plugins: %w", err)
+		}
+
+		log.Info("plugins installation has been scheduled", "plugins", latest)
+	}
+
+	return statuses, nil
+}
+
+// uninstallRemovedPlugins uninstalls the plugins which were managed before and are no longer listed.
+func uninstallRemovedPlugins(
+	jc jenkinsClient.ClientInterface,
+	pm *jenkinsApi.PluginManagement,
+	installed map[string]jenkinsClient.Plugin,
+	current *jenkinsApi.PluginsStatus,
+) ([]jenkinsApi.PluginStatus, error) {
+	desired := make(map[string]bool, len(pm.Plugins))
+	for _, p := range pm.Plugins {
+		desired[p.Name] = true
+	}
+
+	var statuses []jenkinsApi.PluginStatus
+
+	for _, st := range current.Plugins {
+		ip, ok := installed[st.Name]
+		if desired[st.Name] || !ok {
+			continue
+		}
+
+		if !ip.Deleted {
+			if err := jc.UninstallPlugin(st.Name); err != nil {
+				return nil, fmt.Errorf("failed to uninstall plugin %s: %w", st.Name, err)
+			}
+
+			log.Info("plugin has been uninstalled", "plugin", st.Name)
+		}
+
+		statuses = append(statuses, jenkinsApi.PluginStatus{
+			Name:             st.Name,
+			InstalledVersion: ip.Version,
+			State:            jenkinsApi.PluginStatePending,
+		})
+	}
+
+	return statuses, nil
+}
+
+func isPluginPending(current *jenkinsApi.PluginsStatus, p jenkinsApi.JenkinsPlugin) bool {
+	for _, st := range current.Plugins {
+		if st.Name == p.Name {
+			return st.State == jenkinsApi.PluginStatePending && st.DesiredVersion == p.Version
+		}
+	}
+
+	return false
+}
+
+func hasPendingPlugins(status *jenkinsApi.PluginsStatus) bool {
+	for _, st := range status.Plugins {
+		if st.State == jenkinsApi.PluginStatePending {
+			return true
+		}
+	}
+
+	return false
+}
+
+// restartIfRequired schedules a safe restart unless the restart policy is never or a restart has been scheduled recently.
+func (JenkinsServiceImpl) restartIfRequired(
+	jc jenkinsClient.ClientInterface,
+	instance *jenkinsApi.Jenkins,
+	pm *jenkinsApi.PluginManagement,
+	status *jenkinsApi.PluginsStatus,
+) error {
+	if !status.RestartRequired || pm.RestartPolicy == jenkinsApi.PluginsRestartPolicyNever {
+		return nil
+	}
+
+	now := time.Now()
+
+	if status.LastRestartTime != nil && status.LastRestartTime.Add(restartInterval).After(now) {
+		return nil
+	}
+
+	if err := jc.SafeRestart(); err != nil {
+		return fmt.Errorf("failed to restart Jenkins: %w", err)
+	}
+
+	status.LastRestartTime = &metav1.Time{Time: now}
+
+	log.Info("Jenkins safe restart has been scheduled to complete plugin changes", "jenkins", instance.Name)
+
+	return nil
+}
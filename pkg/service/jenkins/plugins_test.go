@@ -0,0 +1,179 @@
+package jenkins
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+	jenkinsClient "github.com/epam/edp-jenkins-operator/v2/pkg/client/jenkins"
+)
+
+func newPluginsTestService(jc *jenkinsClient.ClientMock) JenkinsServiceImpl {
+	builder := jenkinsClient.ClientBuilderMock{}
+	builder.On("MakeNewClient", mock.Anything).Return(jc, nil)
+
+	return JenkinsServiceImpl{jenkinsClientFactory: &builder}
+}
+
+func TestJenkinsServiceImpl_SyncPlugins(t *testing.T) {
+	instance := &jenkinsApi.Jenkins{ObjectMeta: ObjectMeta()}
+	instance.Spec.PluginManagement = &jenkinsApi.PluginManagement{
+		Plugins: []jenkinsApi.JenkinsPlugin{
+			{Name: "git", Version: "4.11.0"},
+			{Name: "ssh-agent"},
+			{Name: "kubernetes", Version: "3600.v144b_cd192ca_a_"},
+			{Name: "matrix-auth"},
+		},
+	}
+	instance.Status.Plugins = &jenkinsApi.PluginsStatus{
+		Plugins: []jenkinsApi.PluginStatus{
+			{Name: "git", DesiredVersion: "4.11.0", InstalledVersion: "4.11.0", State: jenkinsApi.PluginStateInstalled},
+			{Name: "workflow-aggregator", InstalledVersion: "2.6", State: jenkinsApi.PluginStateInstalled},
+		},
+	}
+
+	jc := jenkinsClient.ClientMock{}
+	jc.On("IsRestartRequired").Return(false, nil).Once()
+	jc.On("GetPlugins").Return([]jenkinsClient.Plugin{
+		{ShortName: "git", Version: "4.11.0", Active: true},
+		{ShortName: "kubernetes", Version: "3500.0", Active: true},
+		{ShortName: "matrix-auth", Version: "3.1", Active: true},
+		{ShortName: "workflow-aggregator", Version: "2.6", Active: true},
+	}, nil)
+	jc.On("InstallPlugins", []string{"ssh-agent@latest"}).Return(nil)
+	jc.On("DeployPlugin",
+		"https://updates.jenkins.io/download/plugins/kubernetes/3600.v144b_cd192ca_a_/kubernetes.hpi").Return(nil)
+	jc.On("UninstallPlugin", "workflow-aggregator").Return(nil)
+	jc.On("IsRestartRequired").Return(true, nil).Once()
+	jc.On("SafeRestart").Return(nil)
+
+	_, updated, err := newPluginsTestService(&jc).SyncPlugins(instance)
+	require.NoError(t, err)
+	assert.True(t, updated)
+	jc.AssertExpectations(t)
+
+	status := instance.Status.Plugins
+	assert.True(t, status.RestartRequired)
+	assert.NotNil(t, status.LastRestartTime)
+	assert.Equal(t, []jenkinsApi.PluginStatus{
+		{Name: "git", DesiredVersion: "4.11.0", InstalledVersion: "4.11.0", State: jenkinsApi.PluginStateInstalled},
+		{Name: "ssh-agent", State: jenkinsApi.PluginStatePending},
+		{Name: "kubernetes", DesiredVersion: "3600.v144b_cd192ca_a_", InstalledVersion: "3500.0", State: jenkinsApi.PluginStatePending},
+		{Name: "matrix-auth", InstalledVersion: "3.1", State: jenkinsApi.PluginStateInstalled},
+		{Name: "workflow-aggregator", InstalledVersion: "2.6", State: jenkinsApi.PluginStatePending},
+	}, status.Plugins)
+}
+
+func TestJenkinsServiceImpl_SyncPlugins_PendingRestart(t *testing.T) {
+	lastRestart := metav1.Time{Time: time.Now().Add(-time.Minute)}
+
+	instance := &jenkinsApi.Jenkins{ObjectMeta: ObjectMeta()}
+	instance.Spec.PluginManagement = &jenkinsApi.PluginManagement{
+		Plugins: []jenkinsApi.JenkinsPlugin{{Name: "git", Version: "5.0.0"}},
+	}
+	instance.Status.Plugins = &jenkinsApi.PluginsStatus{
+		Plugins: []jenkinsApi.PluginStatus{
+			{Name: "git", DesiredVersion: "5.0.0", InstalledVersion: "4.11.0", State: jenkinsApi.PluginStatePending},
+			{Name: "ssh-agent", InstalledVersion: "1.0", State: jenkinsApi.PluginStatePending},
+		},
+		RestartRequired: true,
+		LastRestartTime: &lastRestart,
+	}
+
+	jc := jenkinsClient.ClientMock{}
+	jc.On("IsRestartRequired").Return(true, nil)
+	jc.On("GetPlugins").Return([]jenkinsClient.Plugin{
+		{ShortName: "git", Version: "4.11.0", Active: true},
+		{ShortName: "ssh-agent", Version: "1.0", Deleted: true},
+	}, nil)
+
+	_, updated, err := newPluginsTestService(&jc).SyncPlugins(instance)
+	require.NoError(t, err)
+	assert.False(t, updated)
+	jc.AssertExpectations(t)
+	jc.AssertNotCalled(t, "DeployPlugin", mock.Anything)
+	jc.AssertNotCalled(t, "UninstallPlugin", mock.Anything)
+	jc.AssertNotCalled(t, "SafeRestart")
+}
+
+func TestJenkinsServiceImpl_SyncPlugins_RestartPolicyNever(t *testing.T) {
+	instance := &jenkinsApi.Jenkins{ObjectMeta: ObjectMeta()}
+	instance.Spec.PluginManagement = &jenkinsApi.PluginManagement{
+		Plugins:       []jenkinsApi.JenkinsPlugin{{Name: "git", Version: "5.0.0"}},
+		RestartPolicy: jenkinsApi.PluginsRestartPolicyNever,
+		DownloadURL:   "https://mirror.example.com/plugins/",
+	}
+
+	jc := jenkinsClient.ClientMock{}
+	jc.On("IsRestartRequired").Return(false, nil).Once()
+	jc.On("GetPlugins").Return([]jenkinsClient.Plugin{{ShortName: "git", Version: "4.11.0"}}, nil)
+	jc.On("DeployPlugin", "https://mirror.example.com/plugins/git/5.0.0/git.hpi").Return(nil)
+	jc.On("IsRestartRequired").Return(true, nil).Once()
+
+	_, updated, err := newPluginsTestService(&jc).SyncPlugins(instance)
+	require.NoError(t, err)
+	assert.True(t, updated)
+	jc.AssertExpectations(t)
+	jc.AssertNotCalled(t, "SafeRestart")
+	assert.True(t, instance.Status.Plugins.RestartRequired)
+	assert.Nil(t, instance.Status.Plugins.LastRestartTime)
+}
+
+func TestJenkinsServiceImpl_SyncPlugins_Disabled(t *testing.T) {
+	instance := &jenkinsApi.Jenkins{ObjectMeta: ObjectMeta()}
+
+	_, updated, err := newPluginsTestService(&jenkinsClient.ClientMock{}).SyncPlugins(instance)
+	require.NoError(t, err)
+	assert.False(t, updated)
+}
+
+func TestJenkinsServiceImpl_SyncPlugins_InstallErr(t *testing.T) {
+	instance := &jenkinsApi.Jenkins{ObjectMeta: ObjectMeta()}
+	instance.Spec.PluginManagement = &jenkinsApi.PluginManagement{
+		Plugins: []jenkinsApi.JenkinsPlugin{{Name: "git"}},
+	}
+
+	jc := jenkinsClient.ClientMock{}
+	jc.On("IsRestartRequired").Return(false, nil)
+	jc.On("GetPlugins").Return([]jenkinsClient.Plugin{}, nil)
+	jc.On("InstallPlugins", []string{"git@latest"}).Return(errors.New("failed"))
+
+	_, updated, err := newPluginsTestService(&jc).SyncPlugins(instance)
+	require.NoError(t, err)
+	assert.True(t, updated)
+	assert.Equal(t, &jenkinsApi.PluginsStatus{Error: "failed to install plugins: failed"}, instance.Status.Plugins)
+
+	// the error is cleared by the successful synchronization.
+	jc = jenkinsClient.ClientMock{}
+	jc.On("IsRestartRequired").Return(false, nil)
+	jc.On("GetPlugins").Return([]jenkinsClient.Plugin{{ShortName: "git", Version: "4.11.0", Active: true}}, nil)
+
+	_, updated, err = newPluginsTestService(&jc).SyncPlugins(instance)
+	require.NoError(t, err)
+	assert.True(t, updated)
+	assert.Empty(t, instance.Status.Plugins.Error)
+}
+
+func TestJenkinsServiceImpl_SyncPlugins_RestartNotCausedByPlugins(t *testing.T) {
+	instance := &jenkinsApi.Jenkins{ObjectMeta: ObjectMeta()}
+	instance.Spec.PluginManagement = &jenkinsApi.PluginManagement{
+		Plugins: []jenkinsApi.JenkinsPlugin{{Name: "git", Version: "4.11.0"}},
+	}
+
+	jc := jenkinsClient.ClientMock{}
+	jc.On("IsRestartRequired").Return(true, nil)
+	jc.On("GetPlugins").Return([]jenkinsClient.Plugin{{ShortName: "git", Version: "4.11.0", Active: true}}, nil)
+
+	_, updated, err := newPluginsTestService(&jc).SyncPlugins(instance)
+	require.NoError(t, err)
+	assert.True(t, updated)
+	jc.AssertNotCalled(t, "SafeRestart")
+	assert.False(t, instance.Status.Plugins.RestartRequired)
+	assert.Nil(t, instance.Status.Plugins.LastRestartTime)
+}
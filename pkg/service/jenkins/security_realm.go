@@ -0,0 +1,191 @@
+package jenkins
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	coreV1Api "k8s.io/api/core/v1"
+
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+	helperController "github.com/epam/edp-jenkins-operator/v2/pkg/controller/helper"
+	platformHelper "github.com/epam/edp-jenkins-operator/v2/pkg/service/platform/helper"
+)
+
+const (
+	securityRealmTemplateName = "config-security-realm.tmpl"
+	oidcWellKnownPath         = "/.well-known/openid-configuration"
+)
+
+var defaultOIDCScopes = []string{"openid", "email", "profile"}
+
+// secretKeyRef points the script to the key of a Secret, the value is read by Jenkins when the script is run
+// so it is not kept in the script ConfigMap. The checksum makes the script run again when the value is changed.
+type secretKeyRef struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Key       string `json:"key"`
+	Checksum  string `json:"checksum"`
+}
+
+type oidcRealmConfig struct {
+	ClientID               string       `json:"clientID"`
+	ClientSecret           secretKeyRef `json:"clientSecret"`
+	WellKnownURL           string       `json:"wellKnownURL"`
+	Scopes                 string       `json:"scopes"`
+	UserNameField          string       `json:"userNameField"`
+	FullNameField          string       `json:"fullNameField"`
+	EmailField             string       `json:"emailField"`
+	GroupsField            string       `json:"groupsField"`
+	DisableSSLVerification bool         `json:"disableSSLVerification"`
+}
+
+type ldapRealmConfig struct {
+	Server               string        `json:"server"`
+	RootDN               string        `json:"rootDN"`
+	BindDN               string        `json:"bindDN"`
+	BindPassword         *secretKeyRef `json:"bindPassword,omitempty"`
+	UserSearchBase       string        `json:"userSearchBase"`
+	UserSearchFilter     string        `json:"userSearchFilter"`
+	GroupSearchBase      string        `json:"groupSearchBase"`
+	GroupSearchFilter    string        `json:"groupSearchFilter"`
+	DisplayNameAttribute string        `json:"displayNameAttribute"`
+	MailAttribute        string        `json:"mailAttribute"`
+}
+
+type securityRealmConfig struct {
+	OIDC *oidcRealmConfig `json:"oidc,omitempty"`
+	LDAP *ldapRealmConfig `json:"ldap,omitempty"`
+}
+
+// configureSecurityRealm renders the generic OIDC or LDAP security realm script.
+func (j JenkinsServiceImpl) configureSecurityRealm(instance *jenkinsApi.Jenkins) error {
+	config, err := j.newSecurityRealmConfig(instance)
+	if err != nil {
+		return err
+	}
+
+	templatesDirectoryPath, err := platformHelper.CreatePathToTemplateDirectory(DefaultTemplatesDirectory)
+	if err != nil {
+		return fmt.Errorf("failed to create path to template dir: %w", err)
+	}
+
+	jenkinsScriptData := platformHelper.JenkinsScriptData{SecurityRealmConfig: config}
+
+	return createTemplateScript(templatesDirectoryPath, securityRealmTemplateName, j.platformService, &jenkinsScriptData, instance)
+}
+
+// newSecurityRealmConfig returns the base64 encoded JSON configuration of the security realm script.
+func (j JenkinsServiceImpl) newSecurityRealmConfig(instance *jenkinsApi.Jenkins) (string, error) {
+	realm := instance.Spec.SecurityRealm
+
+	if instance.Spec.KeycloakSpec.Enabled {
+		return "", errors.New("securityRealm can not be used together with keycloakSpec")
+	}
+
+	if (realm.OIDC == nil) == (realm.LDAP == nil) {
+		return "", errors.New("exactly one of securityRealm.oidc and securityRealm.ldap must be set")
+	}
+
+	var config securityRealmConfig
+
+	if realm.OIDC != nil {
+		oidc, err := j.newOIDCRealmConfig(instance.Namespace, realm.OIDC)
+		if err != nil {
+			return "", err
+		}
+
+		config.OIDC = oidc
+	}
+
+	if realm.LDAP != nil {
+		ldap, err := j.newLDAPRealmConfig(instance.Namespace, realm.LDAP)
+		if err != nil {
+			return "", err
+		}
+
+		config.LDAP = ldap
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal security realm config: %w", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(data), nil
+}
+
+func (j JenkinsServiceImpl) newOIDCRealmConfig(namespace string, oidc *jenkinsApi.OIDCSecurityRealm) (*oidcRealmConfig, error) {
+	clientSecret, err := j.newSecretKeyRef(namespace, &oidc.ClientSecretRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get OIDC client secret: %w", err)
+	}
+
+	scopes := oidc.Scopes
+	if len(scopes) == 0 {
+		scopes = defaultOIDCScopes
+	}
+
+	return &oidcRealmConfig{
+		ClientID:               oidc.ClientID,
+		ClientSecret:           clientSecret,
+		WellKnownURL:           strings.TrimSuffix(oidc.IssuerURL, "/") + oidcWellKnownPath,
+		Scopes:                 strings.Join(scopes, " "),
+		UserNameField:          helperController.ValueOrDefault(oidc.ClaimMappings.UserName, "preferred_username"),
+		FullNameField:          helperController.ValueOrDefault(oidc.ClaimMappings.FullName, "name"),
+		EmailField:             helperController.ValueOrDefault(oidc.ClaimMappings.Email, "email"),
+		GroupsField:            helperController.ValueOrDefault(oidc.ClaimMappings.Groups, "groups"),
+		DisableSSLVerification: oidc.DisableSSLVerification,
+	}, nil
+}
+
+func (j JenkinsServiceImpl) newLDAPRealmConfig(namespace string, ldap *jenkinsApi.LDAPSecurityRealm) (*ldapRealmConfig, error) {
+	var bindPassword *secretKeyRef
+
+	if ldap.BindPasswordRef != nil {
+		password, err := j.newSecretKeyRef(namespace, ldap.BindPasswordRef)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get LDAP bind password: %w", err)
+		}
+
+		bindPassword = &password
+	}
+
+	return &ldapRealmConfig{
+		Server:               ldap.Server,
+		RootDN:               ldap.RootDN,
+		BindDN:               ldap.BindDN,
+		BindPassword:         bindPassword,
+		UserSearchBase:       ldap.UserSearchBase,
+		UserSearchFilter:     helperController.ValueOrDefault(ldap.UserSearchFilter, "uid={0}"),
+		GroupSearchBase:      ldap.GroupSearchBase,
+		GroupSearchFilter:    ldap.GroupSearchFilter,
+		DisplayNameAttribute: helperController.ValueOrDefault(ldap.DisplayNameAttribute, "displayname"),
+		MailAttribute:        helperController.ValueOrDefault(ldap.MailAttribute, "mail"),
+	}, nil
+}
+
+func (j JenkinsServiceImpl) newSecretKeyRef(namespace string, selector *coreV1Api.SecretKeySelector) (secretKeyRef, error) {
+	data, err := j.platformService.GetSecretData(namespace, selector.Name)
+	if err != nil {
+		return secretKeyRef{}, fmt.Errorf("failed to get secret %s: %w", selector.Name, err)
+	}
+
+	value, ok := data[selector.Key]
+	if !ok {
+		return secretKeyRef{}, fmt.Errorf("secret %s has no key %s", selector.Name, selector.Key)
+	}
+
+	checksum := sha256.Sum256(value)
+
+	return secretKeyRef{
+		Namespace: namespace,
+		Name:      selector.Name,
+		Key:       selector.Key,
+		Checksum:  hex.EncodeToString(checksum[:]),
+	}, nil
+}
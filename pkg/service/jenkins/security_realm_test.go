@@ -0,0 +1,164 @@
+package jenkins
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coreV1Api "k8s.io/api/core/v1"
+
+	pmock "github.com/epam/edp-jenkins-operator/v2/mock/platform"
+	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1"
+	platformHelper "github.com/epam/edp-jenkins-operator/v2/pkg/service/platform/helper"
+)
+
+func decodeString(t *testing.T, encoded string) string {
+	t.Helper()
+
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	require.NoError(t, err)
+
+	return string(data)
+}
+
+func decodeSecurityRealmConfig(t *testing.T, encoded string) securityRealmConfig {
+	t.Helper()
+
+	var config securityRealmConfig
+	require.NoError(t, json.Unmarshal([]byte(decodeString(t, encoded)), &config))
+
+	return config
+}
+
+func TestJenkinsServiceImpl_newSecurityRealmConfig_OIDC(t *testing.T) {
+	platform := pmock.PlatformService{}
+	impl := JenkinsServiceImpl{platformService: &platform}
+
+	instance := &jenkinsApi.Jenkins{ObjectMeta: ObjectMeta()}
+	instance.Spec.SecurityRealm = &jenkinsApi.SecurityRealm{
+		OIDC: &jenkinsApi.OIDCSecurityRealm{
+			IssuerURL: "https://sso.example.com/realms/main/",
+			ClientID:  "jenkins",
+			ClientSecretRef: coreV1Api.SecretKeySelector{
+				LocalObjectReference: coreV1Api.LocalObjectReference{Name: "oidc"},
+				Key:                  "clientSecret",
+			},
+			ClaimMappings: jenkinsApi.OIDCClaimMappings{Groups: "roles"},
+		},
+	}
+
+	platform.On("GetSecretData", namespace, "oidc").
+		Return(map[string][]byte{"clientSecret": []byte("s'cret")}, nil)
+
+	encoded, err := impl.newSecurityRealmConfig(instance)
+	require.NoError(t, err)
+
+	assert.NotContains(t, decodeString(t, encoded), "s'cret")
+
+	config := decodeSecurityRealmConfig(t, encoded)
+	require.Nil(t, config.LDAP)
+	assert.Equal(t, &oidcRealmConfig{
+		ClientID: "jenkins",
+		ClientSecret: secretKeyRef{
+			Namespace: namespace,
+			Name:      "oidc",
+			Key:       "clientSecret",
+			Checksum:  "6911c8c790bfcac79dadb9e702179844fb634b1816d88f8b4782a4100b1ba914",
+		},
+		WellKnownURL:  "https://sso.example.com/realms/main/.well-known/openid-configuration",
+		Scopes:        "openid email profile",
+		UserNameField: "preferred_username",
+		FullNameField: "name",
+		EmailField:    "email",
+		GroupsField:   "roles",
+	}, config.OIDC)
+}
+
+func TestJenkinsServiceImpl_newSecurityRealmConfig_LDAP(t *testing.T) {
+	platform := pmock.PlatformService{}
+	impl := JenkinsServiceImpl{platformService: &platform}
+
+	instance := &jenkinsApi.Jenkins{ObjectMeta: ObjectMeta()}
+	instance.Spec.SecurityRealm = &jenkinsApi.SecurityRealm{
+		LDAP: &jenkinsApi.LDAPSecurityRealm{
+			Server: "ldaps://ldap.example.com",
+			RootDN: "dc=example,dc=com",
+			BindDN: "cn=jenkins,dc=example,dc=com",
+			BindPasswordRef: &coreV1Api.SecretKeySelector{
+				LocalObjectReference: coreV1Api.LocalObjectReference{Name: "ldap"},
+				Key:                  "password",
+			},
+			UserSearchBase:  "ou=people",
+			GroupSearchBase: "ou=groups",
+		},
+	}
+
+	platform.On("GetSecretData", namespace, "ldap").
+		Return(map[string][]byte{"password": []byte("pwd")}, nil)
+
+	encoded, err := impl.newSecurityRealmConfig(instance)
+	require.NoError(t, err)
+
+	assert.NotContains(t, decodeString(t, encoded), "pwd")
+
+	config := decodeSecurityRealmConfig(t, encoded)
+	require.Nil(t, config.OIDC)
+	assert.Equal(t, &ldapRealmConfig{
+		Server: "ldaps://ldap.example.com",
+		RootDN: "dc=example,dc=com",
+		BindDN: "cn=jenkins,dc=example,dc=com",
+		BindPassword: &secretKeyRef{
+			Namespace: namespace,
+			Name:      "ldap",
+			Key:       "password",
+			Checksum:  "a1159e9df3670d549d04524532629f5477ceb7deec9b45e47e8c009506ecb2c8",
+		},
+		UserSearchBase:       "ou=people",
+		UserSearchFilter:     "uid={0}",
+		GroupSearchBase:      "ou=groups",
+		DisplayNameAttribute: "displayname",
+		MailAttribute:        "mail",
+	}, config.LDAP)
+}
+
+func TestJenkinsServiceImpl_newSecurityRealmConfig_Invalid(t *testing.T) {
+	platform := pmock.PlatformService{}
+	impl := JenkinsServiceImpl{platformService: &platform}
+
+	instance := &jenkinsApi.Jenkins{ObjectMeta: ObjectMeta()}
+	instance.Spec.SecurityRealm = &jenkinsApi.SecurityRealm{}
+
+	_, err := impl.newSecurityRealmConfig(instance)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "exactly one of")
+
+	instance.Spec.KeycloakSpec.Enabled = true
+	instance.Spec.SecurityRealm.LDAP = &jenkinsApi.LDAPSecurityRealm{Server: "ldap://ldap"}
+
+	_, err = impl.newSecurityRealmConfig(instance)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "keycloakSpec")
+
+	instance.Spec.KeycloakSpec.Enabled = false
+	instance.Spec.SecurityRealm.LDAP.BindPasswordRef = &coreV1Api.SecretKeySelector{
+		LocalObjectReference: coreV1Api.LocalObjectReference{Name: "ldap"},
+		Key:                  "password",
+	}
+
+	platform.On("GetSecretData", namespace, "ldap").Return(map[string][]byte{}, nil)
+
+	_, err = impl.newSecurityRealmConfig(instance)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "secret ldap has no key password")
+}
+
+func TestSecurityRealmTemplate(t *testing.T) {
+	data := platformHelper.JenkinsScriptData{SecurityRealmConfig: "e30="}
+
+	script, err := platformHelper.ParseTemplate(&data, "../../../build/configs/templates/"+securityRealmTemplateName,
+		securityRealmTemplateName)
+	require.NoError(t, err)
+	assert.Contains(t, script.String(), "'e30='.decodeBase64()")
+}
@@ -27,6 +27,9 @@ type JenkinsScriptData struct {
 	KeycloakClientSecret   string
 	JenkinsUrl             string
 	JenkinsSharedLibraries []jenkinsApi.JenkinsSharedLibraries
+	BuildEventsUrl         string
+	BuildEventsToken       string
+	SecurityRealmConfig    string
 }
 
 // GenerateLabels returns map with labels for k8s objects.
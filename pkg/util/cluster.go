@@ -9,6 +9,7 @@ import (
 const (
 	watchNamespaceEnvVar   = "WATCH_NAMESPACE"
 	debugModeEnvVar        = "DEBUG_MODE"
+	buildEventsURLEnvVar   = "BUILD_EVENTS_URL"
 	inClusterNamespacePath = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
 )
 
@@ -43,3 +44,9 @@ func RunningInCluster() bool {
 
 	return !os.IsNotExist(err)
 }
+
+// GetBuildEventsURL returns the operator build events endpoint URL reachable from Jenkins.
+// Empty value means that build events are not configured in Jenkins.
+func GetBuildEventsURL() string {
+	return os.Getenv(buildEventsURLEnvVar)
+}